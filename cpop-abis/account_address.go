@@ -0,0 +1,156 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrAccountAddressMismatch is returned by AccountAddressCalculator.Verify when
+// a computed address differs from AccountManager.getAccountAddress.
+var ErrAccountAddressMismatch = errors.New("account address mismatch")
+
+// AccountAddressConfig holds the AccountManager state that determines the
+// CREATE2 address of its accounts.
+type AccountAddressConfig struct {
+	AccountManager      common.Address // CREATE2 deployer
+	Implementation      common.Address // accountImplementation
+	EntryPoint          common.Address // entryPointAddress
+	MasterAggregator    common.Address // masterAggregatorAddress
+	DefaultMasterSigner common.Address // used when no master signer is given
+
+	// ProxyCreationCode is type(ERC1967Proxy).creationCode as compiled into
+	// AccountManager. It defaults to the embedded ERC1967Proxy bytecode. The
+	// proxy init code hash differs per account because the constructor
+	// arguments include the initialize call, so the code itself is needed.
+	ProxyCreationCode []byte
+}
+
+// AccountOwners identifies an account by its owner and master signer. A zero
+// master signer selects the AccountManager default.
+type AccountOwners struct {
+	Owner        common.Address
+	MasterSigner common.Address
+}
+
+// AccountAddressCalculator computes AccountManager account addresses locally,
+// mirroring AccountManager.getAccountAddress.
+type AccountAddressCalculator struct {
+	cfg AccountAddressConfig
+}
+
+// NewAccountAddressCalculator creates a calculator for cfg.
+func NewAccountAddressCalculator(cfg AccountAddressConfig) (*AccountAddressCalculator, error) {
+	if len(cfg.ProxyCreationCode) == 0 {
+		_, code, err := contractBytecode("ERC1967Proxy")
+		if err != nil {
+			return nil, fmt.Errorf("proxy creation code: %w", err)
+		}
+		cfg.ProxyCreationCode = code
+	}
+	return &AccountAddressCalculator{cfg: cfg}, nil
+}
+
+// LoadAccountAddressCalculator reads the configuration of the AccountManager
+// at manager once and returns a calculator for it. The calculator has to be
+// reloaded when the implementation, aggregator or default master signer change.
+func LoadAccountAddressCalculator(opts *bind.CallOpts, backend bind.ContractCaller, manager common.Address) (*AccountAddressCalculator, error) {
+	caller, err := NewAccountManagerCaller(manager, backend)
+	if err != nil {
+		return nil, err
+	}
+	cfg := AccountAddressConfig{AccountManager: manager}
+	if cfg.Implementation, err = caller.GetImplementation(opts); err != nil {
+		return nil, fmt.Errorf("account implementation: %w", err)
+	}
+	if cfg.EntryPoint, err = caller.EntryPointAddress(opts); err != nil {
+		return nil, fmt.Errorf("entry point: %w", err)
+	}
+	if cfg.MasterAggregator, err = caller.MasterAggregatorAddress(opts); err != nil {
+		return nil, fmt.Errorf("master aggregator: %w", err)
+	}
+	if cfg.DefaultMasterSigner, err = caller.DefaultMasterSigner(opts); err != nil {
+		return nil, fmt.Errorf("default master signer: %w", err)
+	}
+	return NewAccountAddressCalculator(cfg)
+}
+
+// Config returns the configuration the calculator uses.
+func (c *AccountAddressCalculator) Config() AccountAddressConfig {
+	return c.cfg
+}
+
+// AccountSalt mirrors AccountManager._generateSalt.
+func AccountSalt(owner, masterSigner common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte("Account_V2:"), owner.Bytes(), []byte(":"), masterSigner.Bytes())
+}
+
+// InitCode returns the ERC1967Proxy creation code with constructor arguments
+// deploying the account of owner and masterSigner.
+func (c *AccountAddressCalculator) InitCode(owner, masterSigner common.Address) ([]byte, error) {
+	accountABI, err := AAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	initData, err := accountABI.Pack("initialize", c.cfg.EntryPoint, owner, c.masterSigner(masterSigner), c.cfg.MasterAggregator)
+	if err != nil {
+		return nil, err
+	}
+	proxyABI, err := ERC1967ProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	args, err := proxyABI.Constructor.Inputs.Pack(c.cfg.Implementation, initData)
+	if err != nil {
+		return nil, err
+	}
+	code := make([]byte, 0, len(c.cfg.ProxyCreationCode)+len(args))
+	return append(append(code, c.cfg.ProxyCreationCode...), args...), nil
+}
+
+// Address returns the address of the account of owner and masterSigner.
+func (c *AccountAddressCalculator) Address(owner, masterSigner common.Address) (common.Address, error) {
+	initCode, err := c.InitCode(owner, masterSigner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	salt := AccountSalt(owner, c.masterSigner(masterSigner))
+	return crypto.CreateAddress2(c.cfg.AccountManager, salt, crypto.Keccak256(initCode)), nil
+}
+
+// Verify cross-checks the computed address of each sample against
+// AccountManager.getAccountAddress, returning ErrAccountAddressMismatch on the
+// first difference. Run it at startup to detect a stale configuration or a
+// proxy creation code that differs from the deployed AccountManager.
+func (c *AccountAddressCalculator) Verify(opts *bind.CallOpts, backend bind.ContractCaller, samples ...AccountOwners) error {
+	caller, err := NewAccountManagerCaller(c.cfg.AccountManager, backend)
+	if err != nil {
+		return err
+	}
+	for _, s := range samples {
+		local, err := c.Address(s.Owner, s.MasterSigner)
+		if err != nil {
+			return err
+		}
+		onchain, err := caller.GetAccountAddress(opts, s.Owner, s.MasterSigner)
+		if err != nil {
+			return fmt.Errorf("account address of %s: %w", s.Owner.Hex(), err)
+		}
+		if local != onchain {
+			return fmt.Errorf("%w: owner %s master %s: computed %s, AccountManager returned %s",
+				ErrAccountAddressMismatch, s.Owner.Hex(), c.masterSigner(s.MasterSigner).Hex(), local.Hex(), onchain.Hex())
+		}
+	}
+	return nil
+}
+
+// masterSigner applies the AccountManager default for a zero master signer.
+func (c *AccountAddressCalculator) masterSigner(masterSigner common.Address) common.Address {
+	if masterSigner == (common.Address{}) {
+		return c.cfg.DefaultMasterSigner
+	}
+	return masterSigner
+}
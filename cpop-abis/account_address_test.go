@@ -0,0 +1,80 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestAccountAddressCalculator(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	master := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, &cpoptest.CoreOptions{MasterSigner: master})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calc, err := cpop.LoadAccountAddressCalculator(nil, sim.Client(), core.Addresses.AccountManager)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	samples := []cpop.AccountOwners{
+		{Owner: common.HexToAddress("0x01")},
+		{Owner: common.HexToAddress("0x01"), MasterSigner: master},
+		{Owner: common.HexToAddress("0x02"), MasterSigner: common.HexToAddress("0x03")},
+		{Owner: auth.From, MasterSigner: auth.From},
+	}
+	for _, s := range samples {
+		local, err := calc.Address(s.Owner, s.MasterSigner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		onchain, err := core.AccountManager.GetAccountAddress(nil, s.Owner, s.MasterSigner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if local != onchain {
+			t.Errorf("owner %s master %s: computed %s, AccountManager returned %s", s.Owner.Hex(), s.MasterSigner.Hex(), local.Hex(), onchain.Hex())
+		}
+	}
+	if err := calc.Verify(nil, sim.Client(), samples...); err != nil {
+		t.Fatal(err)
+	}
+
+	// The account is created where the calculator predicted it.
+	owner := common.HexToAddress("0x04")
+	want, err := calc.Address(owner, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := core.AccountManager.CreateAccount(auth, owner, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cpoptest.Mine(sim, tx); err != nil {
+		t.Fatal(err)
+	}
+	if code, err := sim.Client().CodeAt(context.Background(), want, nil); err != nil || len(code) == 0 {
+		t.Fatalf("no account at %s: %v", want.Hex(), err)
+	}
+
+	// A stale default master signer is detected.
+	cfg := calc.Config()
+	cfg.DefaultMasterSigner = common.HexToAddress("0x05")
+	stale, err := cpop.NewAccountAddressCalculator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stale.Verify(nil, sim.Client(), samples[0]); !errors.Is(err, cpop.ErrAccountAddressMismatch) {
+		t.Fatalf("Verify err = %v, want ErrAccountAddressMismatch", err)
+	}
+}
@@ -0,0 +1,277 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultAuctionKeeperPollInterval is the interval AuctionKeeper.Run checks
+// the watched auctions at when none is configured.
+const DefaultAuctionKeeperPollInterval = 15 * time.Second
+
+// AuctionKeeperBackend is the node access needed by AuctionKeeper. It is
+// implemented by *ethclient.Client and the simulated backend client.
+type AuctionKeeperBackend interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// AuctionKeeperConfig configures an AuctionKeeper.
+type AuctionKeeperConfig struct {
+	FromListing  *big.Int      // first listing ID to watch, 1 by default
+	Grace        uint64        // seconds after EndTime before settling
+	PollInterval time.Duration // interval of Run
+}
+
+// AuctionSettlement is a SettleAuction transaction sent by AuctionKeeper.
+type AuctionSettlement struct {
+	ListingID *big.Int
+	Winner    common.Address
+	Amount    *big.Int
+	Tx        *types.Transaction
+}
+
+// AuctionKeeper settles Marketplace auctions once they have ended. It
+// discovers new listings through NextListingId, waits until the Marketplace
+// clock passes their EndTime and sends SettleAuction for the highest bidder of
+// GetHighestBid. Auctions without bids are left to their seller.
+//
+// Time is read with GetCurrentTimestamp, so auctions are settled by the test
+// timestamp when the Marketplace is in test mode, however far it is from the
+// wall clock. At most one settlement per listing is in flight: it is only
+// resent once its receipt shows a revert or its nonce was used by another
+// transaction. The keeper assigns nonces itself, so auth should not be used
+// concurrently by other senders.
+type AuctionKeeper struct {
+	client  *MarketplaceClient
+	backend AuctionKeeperBackend
+	auth    *bind.TransactOpts
+	config  AuctionKeeperConfig
+
+	mu       sync.Mutex
+	next     *big.Int                      // next listing ID to discover
+	open     map[string]*big.Int           // active auctions
+	pending  map[string]*AuctionSettlement // unconfirmed settlements
+	nonce    uint64
+	hasNonce bool
+}
+
+// NewAuctionKeeper returns a keeper for the Marketplace at address sending
+// settlements with auth, which must be the Marketplace owner.
+func NewAuctionKeeper(address common.Address, backend AuctionKeeperBackend, auth *bind.TransactOpts, config AuctionKeeperConfig) (*AuctionKeeper, error) {
+	client, err := NewMarketplaceClient(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	if config.FromListing == nil || config.FromListing.Sign() <= 0 {
+		config.FromListing = big.NewInt(1)
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultAuctionKeeperPollInterval
+	}
+	return &AuctionKeeper{
+		client:  client,
+		backend: backend,
+		auth:    auth,
+		config:  config,
+		next:    new(big.Int).Set(config.FromListing),
+		open:    make(map[string]*big.Int),
+		pending: make(map[string]*AuctionSettlement),
+	}, nil
+}
+
+// Watched returns the IDs of the active auctions being watched, in order.
+func (k *AuctionKeeper) Watched() []*big.Int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	ids := make([]*big.Int, 0, len(k.open))
+	for _, id := range k.open {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+	return ids
+}
+
+// Pending returns the settlements awaiting confirmation.
+func (k *AuctionKeeper) Pending() []*AuctionSettlement {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	out := make([]*AuctionSettlement, 0, len(k.pending))
+	for _, s := range k.pending {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ListingID.Cmp(out[j].ListingID) < 0 })
+	return out
+}
+
+// Run calls Tick every PollInterval until ctx is done or Tick fails. Sent
+// settlements are passed to handle, which may be nil.
+func (k *AuctionKeeper) Run(ctx context.Context, handle func(*AuctionSettlement)) error {
+	ticker := time.NewTicker(k.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		sent, err := k.Tick(ctx)
+		if handle != nil {
+			for _, s := range sent {
+				handle(s)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick discovers new auctions, confirms pending settlements and settles the
+// auctions that have ended. Failures of single listings do not stop the
+// others; they are joined into the returned error.
+func (k *AuctionKeeper) Tick(ctx context.Context) ([]*AuctionSettlement, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	opts := &bind.CallOpts{Context: ctx}
+	if err := k.discover(opts); err != nil {
+		return nil, err
+	}
+	paused, err := k.client.Contract.Paused(opts)
+	if err != nil {
+		return nil, fmt.Errorf("paused: %w", err)
+	}
+	now, err := k.client.Contract.GetCurrentTimestamp(opts)
+	if err != nil {
+		return nil, fmt.Errorf("current timestamp: %w", err)
+	}
+
+	ids := make([]*big.Int, 0, len(k.open))
+	for _, id := range k.open {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+
+	var (
+		sent []*AuctionSettlement
+		errs []error
+	)
+	for _, id := range ids {
+		s, err := k.check(ctx, id, now.Uint64(), paused)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing %s: %w", id, err))
+		}
+		if s != nil {
+			sent = append(sent, s)
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// discover adds the active auctions created since the last call.
+func (k *AuctionKeeper) discover(opts *bind.CallOpts) error {
+	next, err := k.client.Contract.NextListingId(opts)
+	if err != nil {
+		return fmt.Errorf("next listing ID: %w", err)
+	}
+	for ; k.next.Cmp(next) < 0; k.next.Add(k.next, common.Big1) {
+		l, err := k.client.Contract.GetListing(opts, k.next)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", k.next, AsRevertError(err))
+		}
+		if ListingType(l.ListingType) == ListingAuction && ListingStatus(l.Status) == ListingActive {
+			id := new(big.Int).Set(k.next)
+			k.open[id.String()] = id
+		}
+	}
+	return nil
+}
+
+// check confirms the pending settlement of an auction or settles it once it
+// has ended.
+func (k *AuctionKeeper) check(ctx context.Context, id *big.Int, now uint64, paused bool) (*AuctionSettlement, error) {
+	key := id.String()
+	if s := k.pending[key]; s != nil {
+		done, err := k.confirm(ctx, s)
+		if err != nil || !done {
+			return nil, err
+		}
+		delete(k.pending, key)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	l, err := k.client.Contract.GetListing(opts, id)
+	if err != nil {
+		return nil, AsRevertError(err)
+	}
+	if ListingStatus(l.Status) != ListingActive {
+		delete(k.open, key)
+		return nil, nil
+	}
+	if paused || now < bigOrZero(l.EndTime).Uint64()+k.config.Grace {
+		return nil, nil
+	}
+	bid, err := k.client.Contract.GetHighestBid(opts, id)
+	if err != nil {
+		if revert, ok := AsRevertError(err).(*RevertError); ok && revert.Reason == "No bids placed" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("highest bid: %w", AsRevertError(err))
+	}
+	return k.settle(ctx, id, bid)
+}
+
+// confirm reports whether the settlement s is final. A reverted settlement
+// or one whose nonce was taken by another transaction is dropped so the
+// auction is checked again.
+func (k *AuctionKeeper) confirm(ctx context.Context, s *AuctionSettlement) (bool, error) {
+	if _, err := k.backend.TransactionReceipt(ctx, s.Tx.Hash()); err == nil {
+		return true, nil // a reverted settlement is retried while the listing is active
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return false, fmt.Errorf("receipt of %s: %w", s.Tx.Hash().Hex(), err)
+	}
+	nonce, err := k.backend.NonceAt(ctx, k.auth.From, nil)
+	if err != nil {
+		return false, fmt.Errorf("nonce: %w", err)
+	}
+	if nonce <= s.Tx.Nonce() {
+		return false, nil // still in flight
+	}
+	k.hasNonce = false
+	return true, nil
+}
+
+// settle sends SettleAuction for the highest bidder with the next nonce.
+func (k *AuctionKeeper) settle(ctx context.Context, id *big.Int, bid IMarketplaceBid) (*AuctionSettlement, error) {
+	if !k.hasNonce {
+		nonce, err := k.backend.PendingNonceAt(ctx, k.auth.From)
+		if err != nil {
+			return nil, fmt.Errorf("pending nonce: %w", err)
+		}
+		k.nonce, k.hasNonce = nonce, true
+	}
+	auth := *k.auth
+	auth.Context = ctx
+	auth.Nonce = new(big.Int).SetUint64(k.nonce)
+	tx, err := k.client.Contract.SettleAuction(&auth, id, bid.Bidder)
+	if err != nil {
+		// The nonce may or may not have been used, read it again next time.
+		k.hasNonce = false
+		return nil, fmt.Errorf("settle auction: %w", AsRevertError(err))
+	}
+	k.nonce++
+	s := &AuctionSettlement{ListingID: new(big.Int).Set(id), Winner: bid.Bidder, Amount: bid.Amount, Tx: tx}
+	k.pending[id.String()] = s
+	return s, nil
+}
@@ -0,0 +1,151 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestAuctionKeeper(t *testing.T) {
+	ctx := context.Background()
+	nftKey, _ := crypto.GenerateKey()    // owns CPNFT and MockUSDT
+	marketKey, _ := crypto.GenerateKey() // owns Marketplace, runs the keeper and the clock
+	bidderKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(nftKey, marketKey, bidderKey)
+	defer sim.Close()
+	client := sim.Client()
+	nftAuth, marketAuth, bidder := cpoptest.NewTransactor(nftKey), cpoptest.NewTransactor(marketKey), cpoptest.NewTransactor(bidderKey)
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	d := &deployer{t: t, sim: sim}
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	impl := d.mined(cpop.DeployCPNFT(nftAuth, client))
+	nftAddr, tx, nft, err := cpop.DeployCPNFTProxy(nftAuth, client, impl, "CPNFT", "CPNFT", "https://example.com/")
+	d.mined(nftAddr, tx, nft, err)
+	usdtAddr, tx, usdt, err := cpop.DeployMockUSDT(nftAuth, client)
+	d.mined(usdtAddr, tx, usdt, err)
+	impl = d.mined(cpop.DeployMarketplace(marketAuth, client))
+	marketAddr, tx, market, err := cpop.DeployMarketplaceProxy(marketAuth, client, impl, nftAddr, usdtAddr, marketAuth.From, big.NewInt(250), big.NewInt(5), big.NewInt(86400), marketAuth.From)
+	d.mined(marketAddr, tx, market, err)
+	mine(nft.SetMarketplaceContract(nftAuth, marketAddr))
+	mine(nft.Mint(nftAuth, seller, levelC))
+	mine(nft.Mint(nftAuth, seller, levelC))
+	mine(usdt.Mint(nftAuth, bidder.From, big.NewInt(1e9)))
+	mine(usdt.Approve(bidder, marketAddr, big.NewInt(1e9)))
+
+	clock, err := cpoptest.NewTestClock(ctx, client, marketAuth, &cpoptest.TestClockOptions{Simulated: sim}, market)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Listing 1 gets a bid, listing 2 ends without one.
+	for _, id := range []int64{1, 2} {
+		mine(market.CreateListing(marketAuth, seller, big.NewInt(id), uint8(cpop.ListingAuction), big.NewInt(100), big.NewInt(3600), big.NewInt(500)))
+	}
+	mine(market.PlaceBid(marketAuth, big.NewInt(1), bidder.From, bidder.From, big.NewInt(150)))
+	listing, err := market.GetListing(nil, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	endTime := listing.EndTime.Uint64()
+
+	// A fixed gas limit skips estimation, so a failing settlement is mined
+	// and reverts instead of being rejected when sent.
+	keeperAuth := *marketAuth
+	keeperAuth.GasLimit = 1_000_000
+	keeper, err := cpop.NewAuctionKeeper(marketAddr, client, &keeperAuth, cpop.AuctionKeeperConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tick := func(want int) []*cpop.AuctionSettlement {
+		t.Helper()
+		sent, err := keeper.Tick(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sent) != want {
+			t.Fatalf("sent %d settlements, want %d", len(sent), want)
+		}
+		return sent
+	}
+	receipt := func(tx *types.Transaction) *types.Receipt {
+		t.Helper()
+		r, err := client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	tick(0)
+	if watched := keeper.Watched(); len(watched) != 2 {
+		t.Fatalf("watched %v, want both auctions", watched)
+	}
+
+	// Only the test clock passes EndTime.
+	if err := clock.Advance(ctx, 2*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if now := uint64(time.Now().Unix()); now >= endTime || clock.Now() < endTime {
+		t.Fatalf("wall clock %d, test clock %d, end time %d", now, clock.Now(), endTime)
+	}
+
+	// Take the Marketplace rights away so the first settlement reverts.
+	mine(nft.SetMarketplaceContract(nftAuth, common.Address{}))
+	first := tick(1)[0]
+	if first.ListingID.Int64() != 1 || first.Winner != bidder.From || first.Amount.Int64() != 150 {
+		t.Fatalf("settlement %+v", first)
+	}
+	// Nothing is resent while the settlement is pending.
+	tick(0)
+	if pending := keeper.Pending(); len(pending) != 1 || pending[0].Tx.Hash() != first.Tx.Hash() {
+		t.Fatalf("pending %v", pending)
+	}
+	sim.Commit()
+	if r := receipt(first.Tx); r.Status != types.ReceiptStatusFailed {
+		t.Fatal("first settlement did not revert")
+	}
+
+	// The reverted settlement is retried and succeeds.
+	mine(nft.SetMarketplaceContract(nftAuth, marketAddr))
+	retry := tick(1)[0]
+	if retry.Tx.Hash() == first.Tx.Hash() || retry.Tx.Nonce() != first.Tx.Nonce()+1 {
+		t.Fatalf("retry %s nonce %d after %d", retry.Tx.Hash().Hex(), retry.Tx.Nonce(), first.Tx.Nonce())
+	}
+	sim.Commit()
+	if r := receipt(retry.Tx); r.Status != types.ReceiptStatusSuccessful {
+		t.Fatal("retried settlement reverted")
+	}
+
+	// The sold auction is dropped; the one without bids stays watched and is
+	// never settled.
+	tick(0)
+	tick(0)
+	if watched := keeper.Watched(); len(watched) != 1 || watched[0].Int64() != 2 {
+		t.Fatalf("watched %v, want listing 2", watched)
+	}
+	if pending := keeper.Pending(); len(pending) != 0 {
+		t.Fatalf("pending %v", pending)
+	}
+	if owner, err := nft.OwnerOf(nil, big.NewInt(1)); err != nil || owner != bidder.From {
+		t.Fatalf("token 1 owner %s, %v; want the bidder", owner.Hex(), err)
+	}
+	if l, err := market.GetListing(nil, big.NewInt(2)); err != nil || cpop.ListingStatus(l.Status) != cpop.ListingActive {
+		t.Fatalf("listing 2 status %d, %v; want active", l.Status, err)
+	}
+}
@@ -0,0 +1,322 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DefaultReceiptPollInterval is the interval WaitForUserOperationReceipt
+// polls the bundler with when no interval is given.
+const DefaultReceiptPollInterval = 2 * time.Second
+
+// RPCUserOperation is the unpacked ERC-4337 v0.7 JSON representation of a
+// user operation used by the bundler RPC methods.
+type RPCUserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// NewRPCUserOperation unpacks op into the bundler wire format.
+func NewRPCUserOperation(op PackedUserOperation) RPCUserOperation {
+	r := RPCUserOperation{
+		Sender:               op.Sender,
+		Nonce:                (*hexutil.Big)(bigOrZero(op.Nonce)),
+		CallData:             bytesOrEmpty(op.CallData),
+		CallGasLimit:         (*hexutil.Big)(op.CallGasLimit()),
+		VerificationGasLimit: (*hexutil.Big)(op.VerificationGasLimit()),
+		PreVerificationGas:   (*hexutil.Big)(bigOrZero(op.PreVerificationGas)),
+		MaxFeePerGas:         (*hexutil.Big)(op.MaxFeePerGas()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(op.MaxPriorityFeePerGas()),
+		Signature:            bytesOrEmpty(op.Signature),
+	}
+	if len(op.InitCode) >= common.AddressLength {
+		factory := common.BytesToAddress(op.InitCode[:common.AddressLength])
+		r.Factory = &factory
+		r.FactoryData = op.InitCode[common.AddressLength:]
+	}
+	if paymaster, verification, postOp, data, err := UnpackPaymasterAndData(op.PaymasterAndData); err == nil {
+		r.Paymaster = &paymaster
+		r.PaymasterVerificationGasLimit = (*hexutil.Big)(verification)
+		r.PaymasterPostOpGasLimit = (*hexutil.Big)(postOp)
+		r.PaymasterData = data
+	}
+	return r
+}
+
+// Packed converts r back into a PackedUserOperation. It fails if a gas field
+// does not fit into uint128.
+func (r RPCUserOperation) Packed() (PackedUserOperation, error) {
+	accountGasLimits, err := PackAccountGasLimits(hexBig(r.VerificationGasLimit), hexBig(r.CallGasLimit))
+	if err != nil {
+		return PackedUserOperation{}, err
+	}
+	gasFees, err := PackGasFees(hexBig(r.MaxPriorityFeePerGas), hexBig(r.MaxFeePerGas))
+	if err != nil {
+		return PackedUserOperation{}, err
+	}
+	op := PackedUserOperation{
+		Sender:             r.Sender,
+		Nonce:              hexBig(r.Nonce),
+		InitCode:           []byte{},
+		CallData:           bytesOrEmpty(r.CallData),
+		AccountGasLimits:   accountGasLimits,
+		PreVerificationGas: hexBig(r.PreVerificationGas),
+		GasFees:            gasFees,
+		PaymasterAndData:   []byte{},
+		Signature:          bytesOrEmpty(r.Signature),
+	}
+	if r.Factory != nil {
+		op.InitCode = append(r.Factory.Bytes(), r.FactoryData...)
+	}
+	if r.Paymaster != nil {
+		if op.PaymasterAndData, err = PackPaymasterAndData(*r.Paymaster, hexBig(r.PaymasterVerificationGasLimit), hexBig(r.PaymasterPostOpGasLimit), r.PaymasterData); err != nil {
+			return PackedUserOperation{}, err
+		}
+	}
+	return op, nil
+}
+
+// UserOpGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOpGasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+// Apply writes the estimated limits into op, keeping its fees. Paymaster
+// limits are only applied when op already has a paymaster. op is left
+// unchanged if an estimate does not fit into uint128.
+func (e *UserOpGasEstimate) Apply(op *PackedUserOperation) error {
+	accountGasLimits, err := PackAccountGasLimits(hexBig(e.VerificationGasLimit), hexBig(e.CallGasLimit))
+	if err != nil {
+		return err
+	}
+	paymasterAndData := op.PaymasterAndData
+	if paymaster, verification, postOp, data, err := UnpackPaymasterAndData(op.PaymasterAndData); err == nil {
+		if e.PaymasterVerificationGasLimit != nil {
+			verification = e.PaymasterVerificationGasLimit.ToInt()
+		}
+		if e.PaymasterPostOpGasLimit != nil {
+			postOp = e.PaymasterPostOpGasLimit.ToInt()
+		}
+		if paymasterAndData, err = PackPaymasterAndData(paymaster, verification, postOp, data); err != nil {
+			return err
+		}
+	}
+	op.AccountGasLimits = accountGasLimits
+	op.PreVerificationGas = hexBig(e.PreVerificationGas)
+	op.PaymasterAndData = paymasterAndData
+	return nil
+}
+
+// UserOpReceipt is the result of eth_getUserOperationReceipt.
+type UserOpReceipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	EntryPoint    common.Address `json:"entryPoint"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	Paymaster     common.Address `json:"paymaster"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	Success       bool           `json:"success"`
+	Reason        hexutil.Bytes  `json:"reason,omitempty"`
+	Logs          []*types.Log   `json:"logs"`
+	Receipt       *types.Receipt `json:"receipt"`
+}
+
+// RevertReason decodes the reason of a failed operation, or returns "" for a
+// successful one.
+func (r *UserOpReceipt) RevertReason() string {
+	if r.Success {
+		return ""
+	}
+	return DecodeRevertReason(r.Reason)
+}
+
+// Revert returns the decoded revert of a failed operation, if any.
+func (r *UserOpReceipt) Revert() (*RevertError, bool) {
+	if r.Success {
+		return nil, false
+	}
+	return DecodeRevertData(r.Reason)
+}
+
+// BundlerError is a JSON-RPC error returned by the bundler.
+type BundlerError struct {
+	Method  string
+	Code    int
+	Message string
+	Data    interface{}
+	Reason  string       // decoded revert reason from Data, if any
+	Revert  *RevertError // decoded revert from Data, nil if Data is not revert data
+}
+
+func (e *BundlerError) Error() string {
+	msg := fmt.Sprintf("%s: %s (code %d)", e.Method, e.Message, e.Code)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// Unwrap returns the decoded revert so errors.As finds the *RevertError.
+func (e *BundlerError) Unwrap() error {
+	if e.Revert == nil {
+		return nil
+	}
+	return e.Revert
+}
+
+// BundlerClient talks to an ERC-4337 bundler over JSON-RPC.
+type BundlerClient struct {
+	c *rpc.Client
+}
+
+// DialBundler connects to the bundler at url.
+func DialBundler(ctx context.Context, url string) (*BundlerClient, error) {
+	c, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return NewBundlerClient(c), nil
+}
+
+// NewBundlerClient creates a client using an existing RPC connection.
+func NewBundlerClient(c *rpc.Client) *BundlerClient {
+	return &BundlerClient{c: c}
+}
+
+// Close closes the underlying RPC connection.
+func (b *BundlerClient) Close() {
+	b.c.Close()
+}
+
+// SupportedEntryPoints returns the EntryPoints the bundler accepts operations for.
+func (b *BundlerClient) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	var out []common.Address
+	err := b.call(ctx, &out, "eth_supportedEntryPoints")
+	return out, err
+}
+
+// SendUserOperation submits op to entryPoint and returns the userOpHash.
+func (b *BundlerClient) SendUserOperation(ctx context.Context, op PackedUserOperation, entryPoint common.Address) (common.Hash, error) {
+	var hash common.Hash
+	err := b.call(ctx, &hash, "eth_sendUserOperation", NewRPCUserOperation(op), entryPoint)
+	return hash, err
+}
+
+// EstimateUserOperationGas asks the bundler for the gas limits of op.
+func (b *BundlerClient) EstimateUserOperationGas(ctx context.Context, op PackedUserOperation, entryPoint common.Address) (*UserOpGasEstimate, error) {
+	var out UserOpGasEstimate
+	if err := b.call(ctx, &out, "eth_estimateUserOperationGas", NewRPCUserOperation(op), entryPoint); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUserOperationReceipt returns the receipt of userOpHash, or nil if the
+// operation has not been included yet.
+func (b *BundlerClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*UserOpReceipt, error) {
+	var out *UserOpReceipt
+	err := b.call(ctx, &out, "eth_getUserOperationReceipt", userOpHash)
+	return out, err
+}
+
+// WaitForUserOperationReceipt polls GetUserOperationReceipt every interval
+// until the operation is included or ctx is done.
+func (b *BundlerClient) WaitForUserOperationReceipt(ctx context.Context, userOpHash common.Hash, interval time.Duration) (*UserOpReceipt, error) {
+	if interval <= 0 {
+		interval = DefaultReceiptPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		receipt, err := b.GetUserOperationReceipt(ctx, userOpHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// call performs a JSON-RPC call, turning RPC errors into *BundlerError.
+func (b *BundlerClient) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	err := b.c.CallContext(ctx, result, method, args...)
+	if err == nil {
+		return nil
+	}
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+	be := &BundlerError{Method: method, Code: rpcErr.ErrorCode(), Message: rpcErr.Error()}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		be.Data = dataErr.ErrorData()
+		be.Revert, be.Reason = decodeBundlerErrorData(be.Data)
+	}
+	return be
+}
+
+// decodeBundlerErrorData decodes revert data carried in a bundler error,
+// either as a hex string or as an object with a revertData or reason field.
+func decodeBundlerErrorData(data interface{}) (*RevertError, string) {
+	var field string
+	switch d := data.(type) {
+	case string:
+		field = d
+	case map[string]interface{}:
+		for _, key := range []string{"revertData", "reason", "data"} {
+			if s, ok := d[key].(string); ok {
+				field = s
+				break
+			}
+		}
+	}
+	raw, err := hexutil.Decode(field)
+	if err != nil {
+		return nil, field
+	}
+	if revert, ok := DecodeRevertData(raw); ok {
+		return revert, revert.Error()
+	}
+	return nil, DecodeRevertReason(raw)
+}
+
+// hexBig converts an optional JSON quantity, treating nil as zero.
+func hexBig(v *hexutil.Big) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(v.ToInt())
+}
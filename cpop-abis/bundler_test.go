@@ -0,0 +1,226 @@
+package cpop_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// fakeBundlerError is a JSON-RPC error carrying revert data.
+type fakeBundlerError struct {
+	data interface{}
+}
+
+func (e *fakeBundlerError) Error() string          { return "user operation rejected" }
+func (e *fakeBundlerError) ErrorCode() int         { return -32500 }
+func (e *fakeBundlerError) ErrorData() interface{} { return e.data }
+
+// fakeBundler serves canned answers under the eth namespace.
+type fakeBundler struct {
+	mu          sync.Mutex
+	sent        []cpop.RPCUserOperation
+	sendErr     error
+	estimate    cpop.UserOpGasEstimate
+	receipt     *cpop.UserOpReceipt
+	pendingPoll int // receipt polls answered with null before the receipt
+	polls       int
+}
+
+func (f *fakeBundler) SendUserOperation(op cpop.RPCUserOperation, entryPoint common.Address) (common.Hash, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sendErr != nil {
+		return common.Hash{}, f.sendErr
+	}
+	f.sent = append(f.sent, op)
+	return common.HexToHash("0x01"), nil
+}
+
+func (f *fakeBundler) EstimateUserOperationGas(op cpop.RPCUserOperation, entryPoint common.Address) cpop.UserOpGasEstimate {
+	return f.estimate
+}
+
+func (f *fakeBundler) GetUserOperationReceipt(userOpHash common.Hash) *cpop.UserOpReceipt {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.polls++
+	if f.receipt == nil || f.polls <= f.pendingPoll {
+		return nil
+	}
+	return f.receipt
+}
+
+func newFakeBundler(t *testing.T, f *fakeBundler) *cpop.BundlerClient {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", f); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	client, err := cpop.DialBundler(context.Background(), httpServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+		httpServer.Close()
+		server.Stop()
+	})
+	return client
+}
+
+// sponsoredUserOp returns an operation with a factory and a paymaster.
+func sponsoredUserOp(t *testing.T) cpop.PackedUserOperation {
+	t.Helper()
+	op := testUserOp(t)
+	op.InitCode = append(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes(), 0xfa, 0xc7)
+	paymasterAndData, err := cpop.PackPaymasterAndData(common.HexToAddress("0x3333333333333333333333333333333333333333"), big.NewInt(60000), big.NewInt(40000), []byte{0x99})
+	if err != nil {
+		t.Fatal(err)
+	}
+	op.PaymasterAndData = paymasterAndData
+	op.Signature = []byte{0x5e}
+	return op
+}
+
+func TestBundlerClientSend(t *testing.T) {
+	f := &fakeBundler{}
+	client := newFakeBundler(t, f)
+	op := sponsoredUserOp(t)
+	entryPoint := common.HexToAddress("0x4337")
+
+	hash, err := client.SendUserOperation(context.Background(), op, entryPoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != common.HexToHash("0x01") {
+		t.Errorf("hash = %s", hash.Hex())
+	}
+	if len(f.sent) != 1 {
+		t.Fatalf("bundler received %d operations", len(f.sent))
+	}
+	sent := f.sent[0]
+	if sent.Factory == nil || *sent.Factory != common.HexToAddress("0x2222222222222222222222222222222222222222") || !bytes.Equal(sent.FactoryData, []byte{0xfa, 0xc7}) {
+		t.Errorf("factory = %v %x", sent.Factory, sent.FactoryData)
+	}
+	if sent.Paymaster == nil || sent.PaymasterVerificationGasLimit.ToInt().Int64() != 60000 || sent.PaymasterPostOpGasLimit.ToInt().Int64() != 40000 {
+		t.Errorf("paymaster = %v %v %v", sent.Paymaster, sent.PaymasterVerificationGasLimit, sent.PaymasterPostOpGasLimit)
+	}
+	packed, err := sent.Packed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpop.UserOpHash(packed, entryPoint, big.NewInt(56), cpop.EntryPointV08) != cpop.UserOpHash(op, entryPoint, big.NewInt(56), cpop.EntryPointV08) {
+		t.Error("operation changed on the wire")
+	}
+}
+
+func TestBundlerClientEstimate(t *testing.T) {
+	f := &fakeBundler{estimate: cpop.UserOpGasEstimate{
+		PreVerificationGas:            (*hexutil.Big)(big.NewInt(45000)),
+		VerificationGasLimit:          (*hexutil.Big)(big.NewInt(150000)),
+		CallGasLimit:                  (*hexutil.Big)(big.NewInt(80000)),
+		PaymasterVerificationGasLimit: (*hexutil.Big)(big.NewInt(70000)),
+	}}
+	client := newFakeBundler(t, f)
+	op := sponsoredUserOp(t)
+
+	estimate, err := client.EstimateUserOperationGas(context.Background(), op, common.HexToAddress("0x4337"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := estimate.Apply(&op); err != nil {
+		t.Fatal(err)
+	}
+	if op.VerificationGasLimit().Int64() != 150000 || op.CallGasLimit().Int64() != 80000 || op.PreVerificationGas.Int64() != 45000 {
+		t.Errorf("gas = %s %s %s", op.VerificationGasLimit(), op.CallGasLimit(), op.PreVerificationGas)
+	}
+	if op.MaxFeePerGas().Int64() != 2e9 {
+		t.Errorf("max fee = %s, want the fee kept", op.MaxFeePerGas())
+	}
+	_, verification, postOp, data, err := cpop.UnpackPaymasterAndData(op.PaymasterAndData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verification.Int64() != 70000 || postOp.Int64() != 40000 || !bytes.Equal(data, []byte{0x99}) {
+		t.Errorf("paymaster = %s %s %x, want the postOp limit and data kept", verification, postOp, data)
+	}
+}
+
+func TestBundlerClientWaitForReceipt(t *testing.T) {
+	f := &fakeBundler{
+		receipt:     &cpop.UserOpReceipt{UserOpHash: common.HexToHash("0x01"), Success: true},
+		pendingPoll: 2,
+	}
+	client := newFakeBundler(t, f)
+
+	receipt, err := client.WaitForUserOperationReceipt(context.Background(), common.HexToHash("0x01"), time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !receipt.Success || receipt.UserOpHash != common.HexToHash("0x01") {
+		t.Errorf("receipt = %+v", receipt)
+	}
+	if f.polls != 3 {
+		t.Errorf("polled %d times, want 3", f.polls)
+	}
+}
+
+func TestBundlerClientWaitForReceiptTimeout(t *testing.T) {
+	client := newFakeBundler(t, &fakeBundler{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	receipt, err := client.WaitForUserOperationReceipt(ctx, common.HexToHash("0x01"), 5*time.Millisecond)
+	if receipt != nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("receipt %v, err %v; want context.DeadlineExceeded", receipt, err)
+	}
+}
+
+func TestBundlerClientError(t *testing.T) {
+	parsed, err := cpop.EntryPointMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	failedOp := parsed.Errors["FailedOp"]
+	args, err := failedOp.Inputs.Pack(big.NewInt(0), "AA21 didn't pay prefund")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := hexutil.Encode(append(failedOp.ID[:4:4], args...))
+
+	for name, errData := range map[string]interface{}{
+		"hex":    data,
+		"object": map[string]interface{}{"revertData": data},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := newFakeBundler(t, &fakeBundler{sendErr: &fakeBundlerError{data: errData}})
+			_, err := client.SendUserOperation(context.Background(), testUserOp(t), common.HexToAddress("0x4337"))
+			var bundlerErr *cpop.BundlerError
+			if !errors.As(err, &bundlerErr) {
+				t.Fatalf("err = %v, want *BundlerError", err)
+			}
+			if bundlerErr.Code != -32500 || bundlerErr.Method != "eth_sendUserOperation" {
+				t.Errorf("code %d method %s", bundlerErr.Code, bundlerErr.Method)
+			}
+			var revert *cpop.RevertError
+			if !errors.As(err, &revert) {
+				t.Fatalf("err = %v, want a *RevertError", err)
+			}
+			if revert.Name != "FailedOp" || revert.Reason != "AA21 didn't pay prefund" {
+				t.Errorf("revert = %s %q", revert.Name, revert.Reason)
+			}
+		})
+	}
+}
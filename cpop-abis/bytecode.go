@@ -0,0 +1,80 @@
+package cpop
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// bytecodeFS holds the creation bytecode of the bound contracts, one <Type>.bin
+// file per contract, extracted from the Hardhat artifacts by generate-bindings.sh.
+//
+//go:embed *.bin
+var bytecodeFS embed.FS
+
+// ErrMissingBytecode is returned by the Deploy functions when the creation
+// bytecode of a contract has not been embedded into the package.
+var ErrMissingBytecode = errors.New("contract bytecode not embedded, run yarn compile and generate-bindings.sh")
+
+// contractMetaData maps the binding type name to its meta data. The name is
+// also the base name of the embedded bytecode file.
+var contractMetaData = map[string]*bind.MetaData{
+	"AAccount":                 AAccountMetaData,
+	"AccountManager":           AccountManagerMetaData,
+	"BatchTransfer":            BatchTransferMetaData,
+	"CPNFT":                    CPNFTMetaData,
+	"CPOPToken":                CPOPTokenMetaData,
+	"ChapoolEarnVault":         ChapoolEarnVaultMetaData,
+	"ChapoolRewardDistributor": ChapoolRewardDistributorMetaData,
+	"ChapoolVaultReader":       ChapoolVaultReaderMetaData,
+	"ERC1967Proxy":             ERC1967ProxyMetaData,
+	"EntryPoint":               EntryPointMetaData,
+	"GasPaymaster":             GasPaymasterMetaData,
+	"GasPriceOracle":           GasPriceOracleMetaData,
+	"Marketplace":              MarketplaceMetaData,
+	"MasterAggregator":         MasterAggregatorMetaData,
+	"MockUSDT":                 MockUSDTMetaData,
+	"NFTBoostController":       NFTBoostControllerMetaData,
+	"Payment":                  PaymentMetaData,
+	"SessionKeyManager":        SessionKeyManagerMetaData,
+	"Staking":                  StakingMetaData,
+	"StakingConfig":            StakingConfigMetaData,
+	"StakingReader":            StakingReaderMetaData,
+	"VeCPOTLocker":             VeCPOTLockerMetaData,
+}
+
+func init() {
+	for name, meta := range contractMetaData {
+		bin, err := bytecodeFS.ReadFile(name + ".bin")
+		if err != nil {
+			continue
+		}
+		if code := strings.TrimPrefix(strings.TrimSpace(string(bin)), "0x"); code != "" {
+			meta.Bin = "0x" + code
+		}
+	}
+}
+
+// HasBytecode reports whether the creation bytecode of the named contract is
+// embedded, i.e. whether its Deploy function can be used.
+func HasBytecode(name string) bool {
+	meta, ok := contractMetaData[name]
+	return ok && len(common.FromHex(meta.Bin)) > 0
+}
+
+// contractBytecode returns the parsed ABI and creation bytecode of the named contract.
+func contractBytecode(name string) (*bind.MetaData, []byte, error) {
+	meta, ok := contractMetaData[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown contract %q", name)
+	}
+	code := common.FromHex(meta.Bin)
+	if len(code) == 0 {
+		return nil, nil, fmt.Errorf("%s: %w", name, ErrMissingBytecode)
+	}
+	return meta, code, nil
+}
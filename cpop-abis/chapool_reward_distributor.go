@@ -0,0 +1,1453 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package cpop
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ChapoolRewardDistributorMetaData contains all meta data concerning the ChapoolRewardDistributor contract.
+var ChapoolRewardDistributorMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"SafeERC20FailedOperation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"vault\",\"type\":\"address\"}],\"name\":\"EarnVaultSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"}],\"name\":\"RewardDepositorSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"cppPerSecond\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timestamp\",\"type\":\"uint256\"}],\"name\":\"VaultRewardRateSet\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"earnVault\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVaultRewardRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_owner\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isRewardDepositor\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseEmission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"rescueToken\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_vault\",\"type\":\"address\"}],\"name\":\"setEarnVault\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"enabled\",\"type\":\"bool\"}],\"name\":\"setRewardDepositor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cppPerSecond\",\"type\":\"uint256\"}],\"name\":\"setVaultRewardRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalCppAllocated\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// ChapoolRewardDistributorABI is the input ABI used to generate the binding from.
+// Deprecated: Use ChapoolRewardDistributorMetaData.ABI instead.
+var ChapoolRewardDistributorABI = ChapoolRewardDistributorMetaData.ABI
+
+// ChapoolRewardDistributor is an auto generated Go binding around an Ethereum contract.
+type ChapoolRewardDistributor struct {
+	ChapoolRewardDistributorCaller     // Read-only binding to the contract
+	ChapoolRewardDistributorTransactor // Write-only binding to the contract
+	ChapoolRewardDistributorFilterer   // Log filterer for contract events
+}
+
+// ChapoolRewardDistributorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ChapoolRewardDistributorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChapoolRewardDistributorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ChapoolRewardDistributorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChapoolRewardDistributorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ChapoolRewardDistributorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ChapoolRewardDistributorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ChapoolRewardDistributorSession struct {
+	Contract     *ChapoolRewardDistributor // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ChapoolRewardDistributorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ChapoolRewardDistributorCallerSession struct {
+	Contract *ChapoolRewardDistributorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// ChapoolRewardDistributorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ChapoolRewardDistributorTransactorSession struct {
+	Contract     *ChapoolRewardDistributorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// ChapoolRewardDistributorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ChapoolRewardDistributorRaw struct {
+	Contract *ChapoolRewardDistributor // Generic contract binding to access the raw methods on
+}
+
+// ChapoolRewardDistributorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ChapoolRewardDistributorCallerRaw struct {
+	Contract *ChapoolRewardDistributorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ChapoolRewardDistributorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ChapoolRewardDistributorTransactorRaw struct {
+	Contract *ChapoolRewardDistributorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewChapoolRewardDistributor creates a new instance of ChapoolRewardDistributor, bound to a specific deployed contract.
+func NewChapoolRewardDistributor(address common.Address, backend bind.ContractBackend) (*ChapoolRewardDistributor, error) {
+	contract, err := bindChapoolRewardDistributor(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributor{ChapoolRewardDistributorCaller: ChapoolRewardDistributorCaller{contract: contract}, ChapoolRewardDistributorTransactor: ChapoolRewardDistributorTransactor{contract: contract}, ChapoolRewardDistributorFilterer: ChapoolRewardDistributorFilterer{contract: contract}}, nil
+}
+
+// NewChapoolRewardDistributorCaller creates a new read-only instance of ChapoolRewardDistributor, bound to a specific deployed contract.
+func NewChapoolRewardDistributorCaller(address common.Address, caller bind.ContractCaller) (*ChapoolRewardDistributorCaller, error) {
+	contract, err := bindChapoolRewardDistributor(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorCaller{contract: contract}, nil
+}
+
+// NewChapoolRewardDistributorTransactor creates a new write-only instance of ChapoolRewardDistributor, bound to a specific deployed contract.
+func NewChapoolRewardDistributorTransactor(address common.Address, transactor bind.ContractTransactor) (*ChapoolRewardDistributorTransactor, error) {
+	contract, err := bindChapoolRewardDistributor(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorTransactor{contract: contract}, nil
+}
+
+// NewChapoolRewardDistributorFilterer creates a new log filterer instance of ChapoolRewardDistributor, bound to a specific deployed contract.
+func NewChapoolRewardDistributorFilterer(address common.Address, filterer bind.ContractFilterer) (*ChapoolRewardDistributorFilterer, error) {
+	contract, err := bindChapoolRewardDistributor(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorFilterer{contract: contract}, nil
+}
+
+// bindChapoolRewardDistributor binds a generic wrapper to an already deployed contract.
+func bindChapoolRewardDistributor(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ChapoolRewardDistributorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ChapoolRewardDistributor.Contract.ChapoolRewardDistributorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.ChapoolRewardDistributorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.ChapoolRewardDistributorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ChapoolRewardDistributor.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.contract.Transact(opts, method, params...)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _ChapoolRewardDistributor.Contract.UPGRADEINTERFACEVERSION(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _ChapoolRewardDistributor.Contract.UPGRADEINTERFACEVERSION(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// EarnVault is a free data retrieval call binding the contract method 0xa56c282f.
+//
+// Solidity: function earnVault() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) EarnVault(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "earnVault")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EarnVault is a free data retrieval call binding the contract method 0xa56c282f.
+//
+// Solidity: function earnVault() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) EarnVault() (common.Address, error) {
+	return _ChapoolRewardDistributor.Contract.EarnVault(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// EarnVault is a free data retrieval call binding the contract method 0xa56c282f.
+//
+// Solidity: function earnVault() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) EarnVault() (common.Address, error) {
+	return _ChapoolRewardDistributor.Contract.EarnVault(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// GetVaultRewardRate is a free data retrieval call binding the contract method 0x63dda0fa.
+//
+// Solidity: function getVaultRewardRate() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) GetVaultRewardRate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "getVaultRewardRate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetVaultRewardRate is a free data retrieval call binding the contract method 0x63dda0fa.
+//
+// Solidity: function getVaultRewardRate() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) GetVaultRewardRate() (*big.Int, error) {
+	return _ChapoolRewardDistributor.Contract.GetVaultRewardRate(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// GetVaultRewardRate is a free data retrieval call binding the contract method 0x63dda0fa.
+//
+// Solidity: function getVaultRewardRate() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) GetVaultRewardRate() (*big.Int, error) {
+	return _ChapoolRewardDistributor.Contract.GetVaultRewardRate(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// IsRewardDepositor is a free data retrieval call binding the contract method 0xe2634125.
+//
+// Solidity: function isRewardDepositor(address ) view returns(bool)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) IsRewardDepositor(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "isRewardDepositor", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsRewardDepositor is a free data retrieval call binding the contract method 0xe2634125.
+//
+// Solidity: function isRewardDepositor(address ) view returns(bool)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) IsRewardDepositor(arg0 common.Address) (bool, error) {
+	return _ChapoolRewardDistributor.Contract.IsRewardDepositor(&_ChapoolRewardDistributor.CallOpts, arg0)
+}
+
+// IsRewardDepositor is a free data retrieval call binding the contract method 0xe2634125.
+//
+// Solidity: function isRewardDepositor(address ) view returns(bool)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) IsRewardDepositor(arg0 common.Address) (bool, error) {
+	return _ChapoolRewardDistributor.Contract.IsRewardDepositor(&_ChapoolRewardDistributor.CallOpts, arg0)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) Owner() (common.Address, error) {
+	return _ChapoolRewardDistributor.Contract.Owner(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) Owner() (common.Address, error) {
+	return _ChapoolRewardDistributor.Contract.Owner(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) ProxiableUUID() ([32]byte, error) {
+	return _ChapoolRewardDistributor.Contract.ProxiableUUID(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _ChapoolRewardDistributor.Contract.ProxiableUUID(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// TotalCppAllocated is a free data retrieval call binding the contract method 0x0b715fab.
+//
+// Solidity: function totalCppAllocated() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCaller) TotalCppAllocated(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ChapoolRewardDistributor.contract.Call(opts, &out, "totalCppAllocated")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalCppAllocated is a free data retrieval call binding the contract method 0x0b715fab.
+//
+// Solidity: function totalCppAllocated() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) TotalCppAllocated() (*big.Int, error) {
+	return _ChapoolRewardDistributor.Contract.TotalCppAllocated(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// TotalCppAllocated is a free data retrieval call binding the contract method 0x0b715fab.
+//
+// Solidity: function totalCppAllocated() view returns(uint256)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorCallerSession) TotalCppAllocated() (*big.Int, error) {
+	return _ChapoolRewardDistributor.Contract.TotalCppAllocated(&_ChapoolRewardDistributor.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) Initialize(opts *bind.TransactOpts, _owner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "initialize", _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) Initialize(_owner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.Initialize(&_ChapoolRewardDistributor.TransactOpts, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) Initialize(_owner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.Initialize(&_ChapoolRewardDistributor.TransactOpts, _owner)
+}
+
+// PauseEmission is a paid mutator transaction binding the contract method 0x6e42942a.
+//
+// Solidity: function pauseEmission() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) PauseEmission(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "pauseEmission")
+}
+
+// PauseEmission is a paid mutator transaction binding the contract method 0x6e42942a.
+//
+// Solidity: function pauseEmission() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) PauseEmission() (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.PauseEmission(&_ChapoolRewardDistributor.TransactOpts)
+}
+
+// PauseEmission is a paid mutator transaction binding the contract method 0x6e42942a.
+//
+// Solidity: function pauseEmission() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) PauseEmission() (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.PauseEmission(&_ChapoolRewardDistributor.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.RenounceOwnership(&_ChapoolRewardDistributor.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.RenounceOwnership(&_ChapoolRewardDistributor.TransactOpts)
+}
+
+// RescueToken is a paid mutator transaction binding the contract method 0xe5711e8b.
+//
+// Solidity: function rescueToken(address token, address to, uint256 amount) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) RescueToken(opts *bind.TransactOpts, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "rescueToken", token, to, amount)
+}
+
+// RescueToken is a paid mutator transaction binding the contract method 0xe5711e8b.
+//
+// Solidity: function rescueToken(address token, address to, uint256 amount) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) RescueToken(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.RescueToken(&_ChapoolRewardDistributor.TransactOpts, token, to, amount)
+}
+
+// RescueToken is a paid mutator transaction binding the contract method 0xe5711e8b.
+//
+// Solidity: function rescueToken(address token, address to, uint256 amount) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) RescueToken(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.RescueToken(&_ChapoolRewardDistributor.TransactOpts, token, to, amount)
+}
+
+// SetEarnVault is a paid mutator transaction binding the contract method 0x049a2880.
+//
+// Solidity: function setEarnVault(address _vault) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) SetEarnVault(opts *bind.TransactOpts, _vault common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "setEarnVault", _vault)
+}
+
+// SetEarnVault is a paid mutator transaction binding the contract method 0x049a2880.
+//
+// Solidity: function setEarnVault(address _vault) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) SetEarnVault(_vault common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetEarnVault(&_ChapoolRewardDistributor.TransactOpts, _vault)
+}
+
+// SetEarnVault is a paid mutator transaction binding the contract method 0x049a2880.
+//
+// Solidity: function setEarnVault(address _vault) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) SetEarnVault(_vault common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetEarnVault(&_ChapoolRewardDistributor.TransactOpts, _vault)
+}
+
+// SetRewardDepositor is a paid mutator transaction binding the contract method 0x221a9860.
+//
+// Solidity: function setRewardDepositor(address account, bool enabled) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) SetRewardDepositor(opts *bind.TransactOpts, account common.Address, enabled bool) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "setRewardDepositor", account, enabled)
+}
+
+// SetRewardDepositor is a paid mutator transaction binding the contract method 0x221a9860.
+//
+// Solidity: function setRewardDepositor(address account, bool enabled) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) SetRewardDepositor(account common.Address, enabled bool) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetRewardDepositor(&_ChapoolRewardDistributor.TransactOpts, account, enabled)
+}
+
+// SetRewardDepositor is a paid mutator transaction binding the contract method 0x221a9860.
+//
+// Solidity: function setRewardDepositor(address account, bool enabled) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) SetRewardDepositor(account common.Address, enabled bool) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetRewardDepositor(&_ChapoolRewardDistributor.TransactOpts, account, enabled)
+}
+
+// SetVaultRewardRate is a paid mutator transaction binding the contract method 0x395107ba.
+//
+// Solidity: function setVaultRewardRate(uint256 cppPerSecond) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) SetVaultRewardRate(opts *bind.TransactOpts, cppPerSecond *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "setVaultRewardRate", cppPerSecond)
+}
+
+// SetVaultRewardRate is a paid mutator transaction binding the contract method 0x395107ba.
+//
+// Solidity: function setVaultRewardRate(uint256 cppPerSecond) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) SetVaultRewardRate(cppPerSecond *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetVaultRewardRate(&_ChapoolRewardDistributor.TransactOpts, cppPerSecond)
+}
+
+// SetVaultRewardRate is a paid mutator transaction binding the contract method 0x395107ba.
+//
+// Solidity: function setVaultRewardRate(uint256 cppPerSecond) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) SetVaultRewardRate(cppPerSecond *big.Int) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.SetVaultRewardRate(&_ChapoolRewardDistributor.TransactOpts, cppPerSecond)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.TransferOwnership(&_ChapoolRewardDistributor.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.TransferOwnership(&_ChapoolRewardDistributor.TransactOpts, newOwner)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.UpgradeToAndCall(&_ChapoolRewardDistributor.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ChapoolRewardDistributor.Contract.UpgradeToAndCall(&_ChapoolRewardDistributor.TransactOpts, newImplementation, data)
+}
+
+// ChapoolRewardDistributorEarnVaultSetIterator is returned from FilterEarnVaultSet and is used to iterate over the raw logs and unpacked data for EarnVaultSet events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorEarnVaultSetIterator struct {
+	Event *ChapoolRewardDistributorEarnVaultSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorEarnVaultSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorEarnVaultSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorEarnVaultSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorEarnVaultSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorEarnVaultSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorEarnVaultSet represents a EarnVaultSet event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorEarnVaultSet struct {
+	Vault common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterEarnVaultSet is a free log retrieval operation binding the contract event 0xc7c9463a4db132ecdcbcde59fb5e2ee9eff6b494105114592663663f058fd800.
+//
+// Solidity: event EarnVaultSet(address indexed vault)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterEarnVaultSet(opts *bind.FilterOpts, vault []common.Address) (*ChapoolRewardDistributorEarnVaultSetIterator, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "EarnVaultSet", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorEarnVaultSetIterator{contract: _ChapoolRewardDistributor.contract, event: "EarnVaultSet", logs: logs, sub: sub}, nil
+}
+
+// WatchEarnVaultSet is a free log subscription operation binding the contract event 0xc7c9463a4db132ecdcbcde59fb5e2ee9eff6b494105114592663663f058fd800.
+//
+// Solidity: event EarnVaultSet(address indexed vault)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchEarnVaultSet(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorEarnVaultSet, vault []common.Address) (event.Subscription, error) {
+
+	var vaultRule []interface{}
+	for _, vaultItem := range vault {
+		vaultRule = append(vaultRule, vaultItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "EarnVaultSet", vaultRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorEarnVaultSet)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "EarnVaultSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEarnVaultSet is a log parse operation binding the contract event 0xc7c9463a4db132ecdcbcde59fb5e2ee9eff6b494105114592663663f058fd800.
+//
+// Solidity: event EarnVaultSet(address indexed vault)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseEarnVaultSet(log types.Log) (*ChapoolRewardDistributorEarnVaultSet, error) {
+	event := new(ChapoolRewardDistributorEarnVaultSet)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "EarnVaultSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ChapoolRewardDistributorInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorInitializedIterator struct {
+	Event *ChapoolRewardDistributorInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorInitialized represents a Initialized event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterInitialized(opts *bind.FilterOpts) (*ChapoolRewardDistributorInitializedIterator, error) {
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorInitializedIterator{contract: _ChapoolRewardDistributor.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorInitialized)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseInitialized(log types.Log) (*ChapoolRewardDistributorInitialized, error) {
+	event := new(ChapoolRewardDistributorInitialized)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ChapoolRewardDistributorOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorOwnershipTransferredIterator struct {
+	Event *ChapoolRewardDistributorOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorOwnershipTransferred represents a OwnershipTransferred event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ChapoolRewardDistributorOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorOwnershipTransferredIterator{contract: _ChapoolRewardDistributor.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorOwnershipTransferred)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseOwnershipTransferred(log types.Log) (*ChapoolRewardDistributorOwnershipTransferred, error) {
+	event := new(ChapoolRewardDistributorOwnershipTransferred)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ChapoolRewardDistributorRewardDepositorSetIterator is returned from FilterRewardDepositorSet and is used to iterate over the raw logs and unpacked data for RewardDepositorSet events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorRewardDepositorSetIterator struct {
+	Event *ChapoolRewardDistributorRewardDepositorSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorRewardDepositorSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorRewardDepositorSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorRewardDepositorSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorRewardDepositorSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorRewardDepositorSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorRewardDepositorSet represents a RewardDepositorSet event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorRewardDepositorSet struct {
+	Account common.Address
+	Enabled bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardDepositorSet is a free log retrieval operation binding the contract event 0x7b50f69115f030c28a24ee84c1d8318775fb5898c75d71af91f5c0b61ff5b9bf.
+//
+// Solidity: event RewardDepositorSet(address indexed account, bool enabled)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterRewardDepositorSet(opts *bind.FilterOpts, account []common.Address) (*ChapoolRewardDistributorRewardDepositorSetIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "RewardDepositorSet", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorRewardDepositorSetIterator{contract: _ChapoolRewardDistributor.contract, event: "RewardDepositorSet", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardDepositorSet is a free log subscription operation binding the contract event 0x7b50f69115f030c28a24ee84c1d8318775fb5898c75d71af91f5c0b61ff5b9bf.
+//
+// Solidity: event RewardDepositorSet(address indexed account, bool enabled)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchRewardDepositorSet(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorRewardDepositorSet, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "RewardDepositorSet", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorRewardDepositorSet)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "RewardDepositorSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardDepositorSet is a log parse operation binding the contract event 0x7b50f69115f030c28a24ee84c1d8318775fb5898c75d71af91f5c0b61ff5b9bf.
+//
+// Solidity: event RewardDepositorSet(address indexed account, bool enabled)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseRewardDepositorSet(log types.Log) (*ChapoolRewardDistributorRewardDepositorSet, error) {
+	event := new(ChapoolRewardDistributorRewardDepositorSet)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "RewardDepositorSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ChapoolRewardDistributorUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorUpgradedIterator struct {
+	Event *ChapoolRewardDistributorUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorUpgraded represents a Upgraded event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*ChapoolRewardDistributorUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorUpgradedIterator{contract: _ChapoolRewardDistributor.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorUpgraded)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseUpgraded(log types.Log) (*ChapoolRewardDistributorUpgraded, error) {
+	event := new(ChapoolRewardDistributorUpgraded)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ChapoolRewardDistributorVaultRewardRateSetIterator is returned from FilterVaultRewardRateSet and is used to iterate over the raw logs and unpacked data for VaultRewardRateSet events raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorVaultRewardRateSetIterator struct {
+	Event *ChapoolRewardDistributorVaultRewardRateSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ChapoolRewardDistributorVaultRewardRateSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ChapoolRewardDistributorVaultRewardRateSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ChapoolRewardDistributorVaultRewardRateSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ChapoolRewardDistributorVaultRewardRateSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ChapoolRewardDistributorVaultRewardRateSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ChapoolRewardDistributorVaultRewardRateSet represents a VaultRewardRateSet event raised by the ChapoolRewardDistributor contract.
+type ChapoolRewardDistributorVaultRewardRateSet struct {
+	Sender       common.Address
+	CppPerSecond *big.Int
+	Timestamp    *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterVaultRewardRateSet is a free log retrieval operation binding the contract event 0x8aad02ad82cb1907448e01bfdb5939037334755f3dfb3dd0780e731d714ba4db.
+//
+// Solidity: event VaultRewardRateSet(address indexed sender, uint256 cppPerSecond, uint256 timestamp)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) FilterVaultRewardRateSet(opts *bind.FilterOpts, sender []common.Address) (*ChapoolRewardDistributorVaultRewardRateSetIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.FilterLogs(opts, "VaultRewardRateSet", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ChapoolRewardDistributorVaultRewardRateSetIterator{contract: _ChapoolRewardDistributor.contract, event: "VaultRewardRateSet", logs: logs, sub: sub}, nil
+}
+
+// WatchVaultRewardRateSet is a free log subscription operation binding the contract event 0x8aad02ad82cb1907448e01bfdb5939037334755f3dfb3dd0780e731d714ba4db.
+//
+// Solidity: event VaultRewardRateSet(address indexed sender, uint256 cppPerSecond, uint256 timestamp)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) WatchVaultRewardRateSet(opts *bind.WatchOpts, sink chan<- *ChapoolRewardDistributorVaultRewardRateSet, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ChapoolRewardDistributor.contract.WatchLogs(opts, "VaultRewardRateSet", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ChapoolRewardDistributorVaultRewardRateSet)
+				if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "VaultRewardRateSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVaultRewardRateSet is a log parse operation binding the contract event 0x8aad02ad82cb1907448e01bfdb5939037334755f3dfb3dd0780e731d714ba4db.
+//
+// Solidity: event VaultRewardRateSet(address indexed sender, uint256 cppPerSecond, uint256 timestamp)
+func (_ChapoolRewardDistributor *ChapoolRewardDistributorFilterer) ParseVaultRewardRateSet(log types.Log) (*ChapoolRewardDistributorVaultRewardRateSet, error) {
+	event := new(ChapoolRewardDistributorVaultRewardRateSet)
+	if err := _ChapoolRewardDistributor.contract.UnpackLog(event, "VaultRewardRateSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -31,7 +31,7 @@ var (
 
 // CPNFTMetaData contains all meta data concerning the CPNFT contract.
 var CPNFTMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseTokenURI_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"TokenLevelSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isStaked\",\"type\":\"bool\"}],\"name\":\"TokenStakeStatusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"batchBurn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"to\",\"type\":\"address[]\"},{\"internalType\":\"enumCPNFT.NFTLevel[]\",\"name\":\"levels\",\"type\":\"uint8[]\"}],\"name\":\"batchMint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"from\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"to\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"batchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentTokenId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNextTokenId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getTokenLevel\",\"outputs\":[{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"isStaked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"staked\",\"type\":\"bool\"}],\"name\":\"setStakeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"setTokenLevel\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721IncorrectOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721InsufficientApproval\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOperator\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ERC721InvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC721InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC721InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ERC721NonexistentToken\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newSupply\",\"type\":\"uint256\"}],\"name\":\"LevelSupplyUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"marketplaceContract\",\"type\":\"address\"}],\"name\":\"MarketplaceContractSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"stakingContract\",\"type\":\"address\"}],\"name\":\"StakingContractSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"TokenLevelSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isStaked\",\"type\":\"bool\"}],\"name\":\"TokenStakeStatusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"batchBurn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"to\",\"type\":\"address[]\"},{\"internalType\":\"enumCPNFT.NFTLevel[]\",\"name\":\"levels\",\"type\":\"uint8[]\"}],\"name\":\"batchMint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"from\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"to\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"batchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllLevelSupplies\",\"outputs\":[{\"internalType\":\"uint256[7]\",\"name\":\"\",\"type\":\"uint256[7]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"getLevelSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getTokenLevel\",\"outputs\":[{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"baseTokenURI_\",\"type\":\"string\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"isStaked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"\",\"type\":\"uint8\"}],\"name\":\"levelSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"from\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"to\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"tokenIds\",\"type\":\"uint256[]\"}],\"name\":\"marketplaceBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"marketplaceContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"marketplaceSafeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"marketplaceTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"mint\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"baseURI\",\"type\":\"string\"}],\"name\":\"setBaseURI\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"supply\",\"type\":\"uint256\"}],\"name\":\"setLevelSupply\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_marketplaceContract\",\"type\":\"address\"}],\"name\":\"setMarketplaceContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"staked\",\"type\":\"bool\"}],\"name\":\"setStakeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_stakingContract\",\"type\":\"address\"}],\"name\":\"setStakingContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"enumCPNFT.NFTLevel\",\"name\":\"level\",\"type\":\"uint8\"}],\"name\":\"setTokenLevel\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakingContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
 }
 
 // CPNFTABI is the input ABI used to generate the binding from.
@@ -180,12 +180,43 @@ func (_CPNFT *CPNFTTransactorRaw) Transact(opts *bind.TransactOpts, method strin
 	return _CPNFT.Contract.contract.Transact(opts, method, params...)
 }
 
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_CPNFT *CPNFTCaller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_CPNFT *CPNFTSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _CPNFT.Contract.UPGRADEINTERFACEVERSION(&_CPNFT.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_CPNFT *CPNFTCallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _CPNFT.Contract.UPGRADEINTERFACEVERSION(&_CPNFT.CallOpts)
+}
+
 // BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function balanceOf(address owner_) view returns(uint256)
-func (_CPNFT *CPNFTCaller) BalanceOf(opts *bind.CallOpts, owner_ common.Address) (*big.Int, error) {
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CPNFT *CPNFTCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "balanceOf", owner_)
+	err := _CPNFT.contract.Call(opts, &out, "balanceOf", owner)
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -199,86 +230,86 @@ func (_CPNFT *CPNFTCaller) BalanceOf(opts *bind.CallOpts, owner_ common.Address)
 
 // BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function balanceOf(address owner_) view returns(uint256)
-func (_CPNFT *CPNFTSession) BalanceOf(owner_ common.Address) (*big.Int, error) {
-	return _CPNFT.Contract.BalanceOf(&_CPNFT.CallOpts, owner_)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CPNFT *CPNFTSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _CPNFT.Contract.BalanceOf(&_CPNFT.CallOpts, owner)
 }
 
 // BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
 //
-// Solidity: function balanceOf(address owner_) view returns(uint256)
-func (_CPNFT *CPNFTCallerSession) BalanceOf(owner_ common.Address) (*big.Int, error) {
-	return _CPNFT.Contract.BalanceOf(&_CPNFT.CallOpts, owner_)
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CPNFT *CPNFTCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _CPNFT.Contract.BalanceOf(&_CPNFT.CallOpts, owner)
 }
 
-// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+// GetAllLevelSupplies is a free data retrieval call binding the contract method 0x434ecb13.
 //
-// Solidity: function getApproved(uint256 tokenId) view returns(address)
-func (_CPNFT *CPNFTCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+// Solidity: function getAllLevelSupplies() view returns(uint256[7])
+func (_CPNFT *CPNFTCaller) GetAllLevelSupplies(opts *bind.CallOpts) ([7]*big.Int, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "getApproved", tokenId)
+	err := _CPNFT.contract.Call(opts, &out, "getAllLevelSupplies")
 
 	if err != nil {
-		return *new(common.Address), err
+		return *new([7]*big.Int), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out0 := *abi.ConvertType(out[0], new([7]*big.Int)).(*[7]*big.Int)
 
 	return out0, err
 
 }
 
-// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+// GetAllLevelSupplies is a free data retrieval call binding the contract method 0x434ecb13.
 //
-// Solidity: function getApproved(uint256 tokenId) view returns(address)
-func (_CPNFT *CPNFTSession) GetApproved(tokenId *big.Int) (common.Address, error) {
-	return _CPNFT.Contract.GetApproved(&_CPNFT.CallOpts, tokenId)
+// Solidity: function getAllLevelSupplies() view returns(uint256[7])
+func (_CPNFT *CPNFTSession) GetAllLevelSupplies() ([7]*big.Int, error) {
+	return _CPNFT.Contract.GetAllLevelSupplies(&_CPNFT.CallOpts)
 }
 
-// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+// GetAllLevelSupplies is a free data retrieval call binding the contract method 0x434ecb13.
 //
-// Solidity: function getApproved(uint256 tokenId) view returns(address)
-func (_CPNFT *CPNFTCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
-	return _CPNFT.Contract.GetApproved(&_CPNFT.CallOpts, tokenId)
+// Solidity: function getAllLevelSupplies() view returns(uint256[7])
+func (_CPNFT *CPNFTCallerSession) GetAllLevelSupplies() ([7]*big.Int, error) {
+	return _CPNFT.Contract.GetAllLevelSupplies(&_CPNFT.CallOpts)
 }
 
-// GetCurrentTokenId is a free data retrieval call binding the contract method 0x56189236.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function getCurrentTokenId() view returns(uint256)
-func (_CPNFT *CPNFTCaller) GetCurrentTokenId(opts *bind.CallOpts) (*big.Int, error) {
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CPNFT *CPNFTCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "getCurrentTokenId")
+	err := _CPNFT.contract.Call(opts, &out, "getApproved", tokenId)
 
 	if err != nil {
-		return *new(*big.Int), err
+		return *new(common.Address), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
 
 	return out0, err
 
 }
 
-// GetCurrentTokenId is a free data retrieval call binding the contract method 0x56189236.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function getCurrentTokenId() view returns(uint256)
-func (_CPNFT *CPNFTSession) GetCurrentTokenId() (*big.Int, error) {
-	return _CPNFT.Contract.GetCurrentTokenId(&_CPNFT.CallOpts)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CPNFT *CPNFTSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _CPNFT.Contract.GetApproved(&_CPNFT.CallOpts, tokenId)
 }
 
-// GetCurrentTokenId is a free data retrieval call binding the contract method 0x56189236.
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
 //
-// Solidity: function getCurrentTokenId() view returns(uint256)
-func (_CPNFT *CPNFTCallerSession) GetCurrentTokenId() (*big.Int, error) {
-	return _CPNFT.Contract.GetCurrentTokenId(&_CPNFT.CallOpts)
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CPNFT *CPNFTCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _CPNFT.Contract.GetApproved(&_CPNFT.CallOpts, tokenId)
 }
 
-// GetNextTokenId is a free data retrieval call binding the contract method 0xcaa0f92a.
+// GetLevelSupply is a free data retrieval call binding the contract method 0x0c0f1c84.
 //
-// Solidity: function getNextTokenId() view returns(uint256)
-func (_CPNFT *CPNFTCaller) GetNextTokenId(opts *bind.CallOpts) (*big.Int, error) {
+// Solidity: function getLevelSupply(uint8 level) view returns(uint256)
+func (_CPNFT *CPNFTCaller) GetLevelSupply(opts *bind.CallOpts, level uint8) (*big.Int, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "getNextTokenId")
+	err := _CPNFT.contract.Call(opts, &out, "getLevelSupply", level)
 
 	if err != nil {
 		return *new(*big.Int), err
@@ -290,18 +321,18 @@ func (_CPNFT *CPNFTCaller) GetNextTokenId(opts *bind.CallOpts) (*big.Int, error)
 
 }
 
-// GetNextTokenId is a free data retrieval call binding the contract method 0xcaa0f92a.
+// GetLevelSupply is a free data retrieval call binding the contract method 0x0c0f1c84.
 //
-// Solidity: function getNextTokenId() view returns(uint256)
-func (_CPNFT *CPNFTSession) GetNextTokenId() (*big.Int, error) {
-	return _CPNFT.Contract.GetNextTokenId(&_CPNFT.CallOpts)
+// Solidity: function getLevelSupply(uint8 level) view returns(uint256)
+func (_CPNFT *CPNFTSession) GetLevelSupply(level uint8) (*big.Int, error) {
+	return _CPNFT.Contract.GetLevelSupply(&_CPNFT.CallOpts, level)
 }
 
-// GetNextTokenId is a free data retrieval call binding the contract method 0xcaa0f92a.
+// GetLevelSupply is a free data retrieval call binding the contract method 0x0c0f1c84.
 //
-// Solidity: function getNextTokenId() view returns(uint256)
-func (_CPNFT *CPNFTCallerSession) GetNextTokenId() (*big.Int, error) {
-	return _CPNFT.Contract.GetNextTokenId(&_CPNFT.CallOpts)
+// Solidity: function getLevelSupply(uint8 level) view returns(uint256)
+func (_CPNFT *CPNFTCallerSession) GetLevelSupply(level uint8) (*big.Int, error) {
+	return _CPNFT.Contract.GetLevelSupply(&_CPNFT.CallOpts, level)
 }
 
 // GetTokenLevel is a free data retrieval call binding the contract method 0xd011645c.
@@ -337,10 +368,10 @@ func (_CPNFT *CPNFTCallerSession) GetTokenLevel(tokenId *big.Int) (uint8, error)
 
 // IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function isApprovedForAll(address owner_, address operator) view returns(bool)
-func (_CPNFT *CPNFTCaller) IsApprovedForAll(opts *bind.CallOpts, owner_ common.Address, operator common.Address) (bool, error) {
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CPNFT *CPNFTCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "isApprovedForAll", owner_, operator)
+	err := _CPNFT.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
 
 	if err != nil {
 		return *new(bool), err
@@ -354,16 +385,16 @@ func (_CPNFT *CPNFTCaller) IsApprovedForAll(opts *bind.CallOpts, owner_ common.A
 
 // IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function isApprovedForAll(address owner_, address operator) view returns(bool)
-func (_CPNFT *CPNFTSession) IsApprovedForAll(owner_ common.Address, operator common.Address) (bool, error) {
-	return _CPNFT.Contract.IsApprovedForAll(&_CPNFT.CallOpts, owner_, operator)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CPNFT *CPNFTSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _CPNFT.Contract.IsApprovedForAll(&_CPNFT.CallOpts, owner, operator)
 }
 
 // IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
 //
-// Solidity: function isApprovedForAll(address owner_, address operator) view returns(bool)
-func (_CPNFT *CPNFTCallerSession) IsApprovedForAll(owner_ common.Address, operator common.Address) (bool, error) {
-	return _CPNFT.Contract.IsApprovedForAll(&_CPNFT.CallOpts, owner_, operator)
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CPNFT *CPNFTCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _CPNFT.Contract.IsApprovedForAll(&_CPNFT.CallOpts, owner, operator)
 }
 
 // IsStaked is a free data retrieval call binding the contract method 0xbaa51f86.
@@ -397,6 +428,68 @@ func (_CPNFT *CPNFTCallerSession) IsStaked(tokenId *big.Int) (bool, error) {
 	return _CPNFT.Contract.IsStaked(&_CPNFT.CallOpts, tokenId)
 }
 
+// LevelSupply is a free data retrieval call binding the contract method 0x59412f37.
+//
+// Solidity: function levelSupply(uint8 ) view returns(uint256)
+func (_CPNFT *CPNFTCaller) LevelSupply(opts *bind.CallOpts, arg0 uint8) (*big.Int, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "levelSupply", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LevelSupply is a free data retrieval call binding the contract method 0x59412f37.
+//
+// Solidity: function levelSupply(uint8 ) view returns(uint256)
+func (_CPNFT *CPNFTSession) LevelSupply(arg0 uint8) (*big.Int, error) {
+	return _CPNFT.Contract.LevelSupply(&_CPNFT.CallOpts, arg0)
+}
+
+// LevelSupply is a free data retrieval call binding the contract method 0x59412f37.
+//
+// Solidity: function levelSupply(uint8 ) view returns(uint256)
+func (_CPNFT *CPNFTCallerSession) LevelSupply(arg0 uint8) (*big.Int, error) {
+	return _CPNFT.Contract.LevelSupply(&_CPNFT.CallOpts, arg0)
+}
+
+// MarketplaceContract is a free data retrieval call binding the contract method 0xd1bb5cf1.
+//
+// Solidity: function marketplaceContract() view returns(address)
+func (_CPNFT *CPNFTCaller) MarketplaceContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "marketplaceContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MarketplaceContract is a free data retrieval call binding the contract method 0xd1bb5cf1.
+//
+// Solidity: function marketplaceContract() view returns(address)
+func (_CPNFT *CPNFTSession) MarketplaceContract() (common.Address, error) {
+	return _CPNFT.Contract.MarketplaceContract(&_CPNFT.CallOpts)
+}
+
+// MarketplaceContract is a free data retrieval call binding the contract method 0xd1bb5cf1.
+//
+// Solidity: function marketplaceContract() view returns(address)
+func (_CPNFT *CPNFTCallerSession) MarketplaceContract() (common.Address, error) {
+	return _CPNFT.Contract.MarketplaceContract(&_CPNFT.CallOpts)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
@@ -490,43 +583,74 @@ func (_CPNFT *CPNFTCallerSession) OwnerOf(tokenId *big.Int) (common.Address, err
 	return _CPNFT.Contract.OwnerOf(&_CPNFT.CallOpts, tokenId)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
 //
-// Solidity: function symbol() view returns(string)
-func (_CPNFT *CPNFTCaller) Symbol(opts *bind.CallOpts) (string, error) {
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CPNFT *CPNFTCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "symbol")
+	err := _CPNFT.contract.Call(opts, &out, "proxiableUUID")
 
 	if err != nil {
-		return *new(string), err
+		return *new([32]byte), err
 	}
 
-	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
 
 	return out0, err
 
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
 //
-// Solidity: function symbol() view returns(string)
-func (_CPNFT *CPNFTSession) Symbol() (string, error) {
-	return _CPNFT.Contract.Symbol(&_CPNFT.CallOpts)
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CPNFT *CPNFTSession) ProxiableUUID() ([32]byte, error) {
+	return _CPNFT.Contract.ProxiableUUID(&_CPNFT.CallOpts)
 }
 
-// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
 //
-// Solidity: function symbol() view returns(string)
-func (_CPNFT *CPNFTCallerSession) Symbol() (string, error) {
-	return _CPNFT.Contract.Symbol(&_CPNFT.CallOpts)
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CPNFT *CPNFTCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _CPNFT.Contract.ProxiableUUID(&_CPNFT.CallOpts)
+}
+
+// StakingContract is a free data retrieval call binding the contract method 0xee99205c.
+//
+// Solidity: function stakingContract() view returns(address)
+func (_CPNFT *CPNFTCaller) StakingContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "stakingContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// StakingContract is a free data retrieval call binding the contract method 0xee99205c.
+//
+// Solidity: function stakingContract() view returns(address)
+func (_CPNFT *CPNFTSession) StakingContract() (common.Address, error) {
+	return _CPNFT.Contract.StakingContract(&_CPNFT.CallOpts)
+}
+
+// StakingContract is a free data retrieval call binding the contract method 0xee99205c.
+//
+// Solidity: function stakingContract() view returns(address)
+func (_CPNFT *CPNFTCallerSession) StakingContract() (common.Address, error) {
+	return _CPNFT.Contract.StakingContract(&_CPNFT.CallOpts)
 }
 
-// TokenExists is a free data retrieval call binding the contract method 0x00923f9e.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
 //
-// Solidity: function tokenExists(uint256 tokenId) view returns(bool)
-func (_CPNFT *CPNFTCaller) TokenExists(opts *bind.CallOpts, tokenId *big.Int) (bool, error) {
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CPNFT *CPNFTCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
 	var out []interface{}
-	err := _CPNFT.contract.Call(opts, &out, "tokenExists", tokenId)
+	err := _CPNFT.contract.Call(opts, &out, "supportsInterface", interfaceId)
 
 	if err != nil {
 		return *new(bool), err
@@ -538,18 +662,49 @@ func (_CPNFT *CPNFTCaller) TokenExists(opts *bind.CallOpts, tokenId *big.Int) (b
 
 }
 
-// TokenExists is a free data retrieval call binding the contract method 0x00923f9e.
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CPNFT *CPNFTSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CPNFT.Contract.SupportsInterface(&_CPNFT.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CPNFT *CPNFTCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CPNFT.Contract.SupportsInterface(&_CPNFT.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CPNFT *CPNFTCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
-// Solidity: function tokenExists(uint256 tokenId) view returns(bool)
-func (_CPNFT *CPNFTSession) TokenExists(tokenId *big.Int) (bool, error) {
-	return _CPNFT.Contract.TokenExists(&_CPNFT.CallOpts, tokenId)
+// Solidity: function symbol() view returns(string)
+func (_CPNFT *CPNFTSession) Symbol() (string, error) {
+	return _CPNFT.Contract.Symbol(&_CPNFT.CallOpts)
 }
 
-// TokenExists is a free data retrieval call binding the contract method 0x00923f9e.
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
 //
-// Solidity: function tokenExists(uint256 tokenId) view returns(bool)
-func (_CPNFT *CPNFTCallerSession) TokenExists(tokenId *big.Int) (bool, error) {
-	return _CPNFT.Contract.TokenExists(&_CPNFT.CallOpts, tokenId)
+// Solidity: function symbol() view returns(string)
+func (_CPNFT *CPNFTCallerSession) Symbol() (string, error) {
+	return _CPNFT.Contract.Symbol(&_CPNFT.CallOpts)
 }
 
 // TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
@@ -583,6 +738,37 @@ func (_CPNFT *CPNFTCallerSession) TokenURI(tokenId *big.Int) (string, error) {
 	return _CPNFT.Contract.TokenURI(&_CPNFT.CallOpts, tokenId)
 }
 
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_CPNFT *CPNFTCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CPNFT.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_CPNFT *CPNFTSession) Version() (string, error) {
+	return _CPNFT.Contract.Version(&_CPNFT.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_CPNFT *CPNFTCallerSession) Version() (string, error) {
+	return _CPNFT.Contract.Version(&_CPNFT.CallOpts)
+}
+
 // Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
 //
 // Solidity: function approve(address to, uint256 tokenId) returns()
@@ -688,6 +874,90 @@ func (_CPNFT *CPNFTTransactorSession) Burn(tokenId *big.Int) (*types.Transaction
 	return _CPNFT.Contract.Burn(&_CPNFT.TransactOpts, tokenId)
 }
 
+// Initialize is a paid mutator transaction binding the contract method 0xa6487c53.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseTokenURI_) returns()
+func (_CPNFT *CPNFTTransactor) Initialize(opts *bind.TransactOpts, name_ string, symbol_ string, baseTokenURI_ string) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "initialize", name_, symbol_, baseTokenURI_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6487c53.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseTokenURI_) returns()
+func (_CPNFT *CPNFTSession) Initialize(name_ string, symbol_ string, baseTokenURI_ string) (*types.Transaction, error) {
+	return _CPNFT.Contract.Initialize(&_CPNFT.TransactOpts, name_, symbol_, baseTokenURI_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6487c53.
+//
+// Solidity: function initialize(string name_, string symbol_, string baseTokenURI_) returns()
+func (_CPNFT *CPNFTTransactorSession) Initialize(name_ string, symbol_ string, baseTokenURI_ string) (*types.Transaction, error) {
+	return _CPNFT.Contract.Initialize(&_CPNFT.TransactOpts, name_, symbol_, baseTokenURI_)
+}
+
+// MarketplaceBatchTransferFrom is a paid mutator transaction binding the contract method 0x3c0cc0e5.
+//
+// Solidity: function marketplaceBatchTransferFrom(address[] from, address[] to, uint256[] tokenIds) returns()
+func (_CPNFT *CPNFTTransactor) MarketplaceBatchTransferFrom(opts *bind.TransactOpts, from []common.Address, to []common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "marketplaceBatchTransferFrom", from, to, tokenIds)
+}
+
+// MarketplaceBatchTransferFrom is a paid mutator transaction binding the contract method 0x3c0cc0e5.
+//
+// Solidity: function marketplaceBatchTransferFrom(address[] from, address[] to, uint256[] tokenIds) returns()
+func (_CPNFT *CPNFTSession) MarketplaceBatchTransferFrom(from []common.Address, to []common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceBatchTransferFrom(&_CPNFT.TransactOpts, from, to, tokenIds)
+}
+
+// MarketplaceBatchTransferFrom is a paid mutator transaction binding the contract method 0x3c0cc0e5.
+//
+// Solidity: function marketplaceBatchTransferFrom(address[] from, address[] to, uint256[] tokenIds) returns()
+func (_CPNFT *CPNFTTransactorSession) MarketplaceBatchTransferFrom(from []common.Address, to []common.Address, tokenIds []*big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceBatchTransferFrom(&_CPNFT.TransactOpts, from, to, tokenIds)
+}
+
+// MarketplaceSafeTransferFrom is a paid mutator transaction binding the contract method 0x4fc189d8.
+//
+// Solidity: function marketplaceSafeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTTransactor) MarketplaceSafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "marketplaceSafeTransferFrom", from, to, tokenId)
+}
+
+// MarketplaceSafeTransferFrom is a paid mutator transaction binding the contract method 0x4fc189d8.
+//
+// Solidity: function marketplaceSafeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTSession) MarketplaceSafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceSafeTransferFrom(&_CPNFT.TransactOpts, from, to, tokenId)
+}
+
+// MarketplaceSafeTransferFrom is a paid mutator transaction binding the contract method 0x4fc189d8.
+//
+// Solidity: function marketplaceSafeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTTransactorSession) MarketplaceSafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceSafeTransferFrom(&_CPNFT.TransactOpts, from, to, tokenId)
+}
+
+// MarketplaceTransferFrom is a paid mutator transaction binding the contract method 0x397ffe1e.
+//
+// Solidity: function marketplaceTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTTransactor) MarketplaceTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "marketplaceTransferFrom", from, to, tokenId)
+}
+
+// MarketplaceTransferFrom is a paid mutator transaction binding the contract method 0x397ffe1e.
+//
+// Solidity: function marketplaceTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTSession) MarketplaceTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceTransferFrom(&_CPNFT.TransactOpts, from, to, tokenId)
+}
+
+// MarketplaceTransferFrom is a paid mutator transaction binding the contract method 0x397ffe1e.
+//
+// Solidity: function marketplaceTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CPNFT *CPNFTTransactorSession) MarketplaceTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.MarketplaceTransferFrom(&_CPNFT.TransactOpts, from, to, tokenId)
+}
+
 // Mint is a paid mutator transaction binding the contract method 0x691562a0.
 //
 // Solidity: function mint(address to, uint8 level) returns(uint256)
@@ -709,6 +979,27 @@ func (_CPNFT *CPNFTTransactorSession) Mint(to common.Address, level uint8) (*typ
 	return _CPNFT.Contract.Mint(&_CPNFT.TransactOpts, to, level)
 }
 
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CPNFT *CPNFTTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CPNFT *CPNFTSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CPNFT.Contract.RenounceOwnership(&_CPNFT.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CPNFT *CPNFTTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CPNFT.Contract.RenounceOwnership(&_CPNFT.TransactOpts)
+}
+
 // SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
 //
 // Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
@@ -732,23 +1023,23 @@ func (_CPNFT *CPNFTTransactorSession) SafeTransferFrom(from common.Address, to c
 
 // SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_CPNFT *CPNFTTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _CPNFT.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CPNFT *CPNFTTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
 }
 
 // SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_CPNFT *CPNFTSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _CPNFT.Contract.SafeTransferFrom0(&_CPNFT.TransactOpts, from, to, tokenId, _data)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CPNFT *CPNFTSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CPNFT.Contract.SafeTransferFrom0(&_CPNFT.TransactOpts, from, to, tokenId, data)
 }
 
 // SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
 //
-// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
-func (_CPNFT *CPNFTTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
-	return _CPNFT.Contract.SafeTransferFrom0(&_CPNFT.TransactOpts, from, to, tokenId, _data)
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CPNFT *CPNFTTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CPNFT.Contract.SafeTransferFrom0(&_CPNFT.TransactOpts, from, to, tokenId, data)
 }
 
 // SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
@@ -793,6 +1084,48 @@ func (_CPNFT *CPNFTTransactorSession) SetBaseURI(baseURI string) (*types.Transac
 	return _CPNFT.Contract.SetBaseURI(&_CPNFT.TransactOpts, baseURI)
 }
 
+// SetLevelSupply is a paid mutator transaction binding the contract method 0x622b2b33.
+//
+// Solidity: function setLevelSupply(uint8 level, uint256 supply) returns()
+func (_CPNFT *CPNFTTransactor) SetLevelSupply(opts *bind.TransactOpts, level uint8, supply *big.Int) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "setLevelSupply", level, supply)
+}
+
+// SetLevelSupply is a paid mutator transaction binding the contract method 0x622b2b33.
+//
+// Solidity: function setLevelSupply(uint8 level, uint256 supply) returns()
+func (_CPNFT *CPNFTSession) SetLevelSupply(level uint8, supply *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetLevelSupply(&_CPNFT.TransactOpts, level, supply)
+}
+
+// SetLevelSupply is a paid mutator transaction binding the contract method 0x622b2b33.
+//
+// Solidity: function setLevelSupply(uint8 level, uint256 supply) returns()
+func (_CPNFT *CPNFTTransactorSession) SetLevelSupply(level uint8, supply *big.Int) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetLevelSupply(&_CPNFT.TransactOpts, level, supply)
+}
+
+// SetMarketplaceContract is a paid mutator transaction binding the contract method 0x1102610e.
+//
+// Solidity: function setMarketplaceContract(address _marketplaceContract) returns()
+func (_CPNFT *CPNFTTransactor) SetMarketplaceContract(opts *bind.TransactOpts, _marketplaceContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "setMarketplaceContract", _marketplaceContract)
+}
+
+// SetMarketplaceContract is a paid mutator transaction binding the contract method 0x1102610e.
+//
+// Solidity: function setMarketplaceContract(address _marketplaceContract) returns()
+func (_CPNFT *CPNFTSession) SetMarketplaceContract(_marketplaceContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetMarketplaceContract(&_CPNFT.TransactOpts, _marketplaceContract)
+}
+
+// SetMarketplaceContract is a paid mutator transaction binding the contract method 0x1102610e.
+//
+// Solidity: function setMarketplaceContract(address _marketplaceContract) returns()
+func (_CPNFT *CPNFTTransactorSession) SetMarketplaceContract(_marketplaceContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetMarketplaceContract(&_CPNFT.TransactOpts, _marketplaceContract)
+}
+
 // SetStakeStatus is a paid mutator transaction binding the contract method 0xe7b82624.
 //
 // Solidity: function setStakeStatus(uint256 tokenId, bool staked) returns()
@@ -814,6 +1147,27 @@ func (_CPNFT *CPNFTTransactorSession) SetStakeStatus(tokenId *big.Int, staked bo
 	return _CPNFT.Contract.SetStakeStatus(&_CPNFT.TransactOpts, tokenId, staked)
 }
 
+// SetStakingContract is a paid mutator transaction binding the contract method 0x9dd373b9.
+//
+// Solidity: function setStakingContract(address _stakingContract) returns()
+func (_CPNFT *CPNFTTransactor) SetStakingContract(opts *bind.TransactOpts, _stakingContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "setStakingContract", _stakingContract)
+}
+
+// SetStakingContract is a paid mutator transaction binding the contract method 0x9dd373b9.
+//
+// Solidity: function setStakingContract(address _stakingContract) returns()
+func (_CPNFT *CPNFTSession) SetStakingContract(_stakingContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetStakingContract(&_CPNFT.TransactOpts, _stakingContract)
+}
+
+// SetStakingContract is a paid mutator transaction binding the contract method 0x9dd373b9.
+//
+// Solidity: function setStakingContract(address _stakingContract) returns()
+func (_CPNFT *CPNFTTransactorSession) SetStakingContract(_stakingContract common.Address) (*types.Transaction, error) {
+	return _CPNFT.Contract.SetStakingContract(&_CPNFT.TransactOpts, _stakingContract)
+}
+
 // SetTokenLevel is a paid mutator transaction binding the contract method 0x08ad480b.
 //
 // Solidity: function setTokenLevel(uint256 tokenId, uint8 level) returns()
@@ -877,6 +1231,27 @@ func (_CPNFT *CPNFTTransactorSession) TransferOwnership(newOwner common.Address)
 	return _CPNFT.Contract.TransferOwnership(&_CPNFT.TransactOpts, newOwner)
 }
 
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_CPNFT *CPNFTTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CPNFT.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_CPNFT *CPNFTSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CPNFT.Contract.UpgradeToAndCall(&_CPNFT.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_CPNFT *CPNFTTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CPNFT.Contract.UpgradeToAndCall(&_CPNFT.TransactOpts, newImplementation, data)
+}
+
 // CPNFTApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the CPNFT contract.
 type CPNFTApprovalIterator struct {
 	Event *CPNFTApproval // Event containing the contract specifics and raw log
@@ -1193,9 +1568,9 @@ func (_CPNFT *CPNFTFilterer) ParseApprovalForAll(log types.Log) (*CPNFTApprovalF
 	return event, nil
 }
 
-// CPNFTOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the CPNFT contract.
-type CPNFTOwnershipTransferredIterator struct {
-	Event *CPNFTOwnershipTransferred // Event containing the contract specifics and raw log
+// CPNFTInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the CPNFT contract.
+type CPNFTInitializedIterator struct {
+	Event *CPNFTInitialized // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1209,7 +1584,7 @@ type CPNFTOwnershipTransferredIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *CPNFTOwnershipTransferredIterator) Next() bool {
+func (it *CPNFTInitializedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1218,7 +1593,430 @@ func (it *CPNFTOwnershipTransferredIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(CPNFTOwnershipTransferred)
+			it.Event = new(CPNFTInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CPNFTInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CPNFTInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CPNFTInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CPNFTInitialized represents a Initialized event raised by the CPNFT contract.
+type CPNFTInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_CPNFT *CPNFTFilterer) FilterInitialized(opts *bind.FilterOpts) (*CPNFTInitializedIterator, error) {
+
+	logs, sub, err := _CPNFT.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &CPNFTInitializedIterator{contract: _CPNFT.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_CPNFT *CPNFTFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *CPNFTInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _CPNFT.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CPNFTInitialized)
+				if err := _CPNFT.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_CPNFT *CPNFTFilterer) ParseInitialized(log types.Log) (*CPNFTInitialized, error) {
+	event := new(CPNFTInitialized)
+	if err := _CPNFT.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CPNFTLevelSupplyUpdatedIterator is returned from FilterLevelSupplyUpdated and is used to iterate over the raw logs and unpacked data for LevelSupplyUpdated events raised by the CPNFT contract.
+type CPNFTLevelSupplyUpdatedIterator struct {
+	Event *CPNFTLevelSupplyUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CPNFTLevelSupplyUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CPNFTLevelSupplyUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CPNFTLevelSupplyUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CPNFTLevelSupplyUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CPNFTLevelSupplyUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CPNFTLevelSupplyUpdated represents a LevelSupplyUpdated event raised by the CPNFT contract.
+type CPNFTLevelSupplyUpdated struct {
+	Level     uint8
+	NewSupply *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLevelSupplyUpdated is a free log retrieval operation binding the contract event 0x58e8ca5f8a3463635e518701d45d8eee43e686dfad74b665ef71b814c0e115c0.
+//
+// Solidity: event LevelSupplyUpdated(uint8 indexed level, uint256 newSupply)
+func (_CPNFT *CPNFTFilterer) FilterLevelSupplyUpdated(opts *bind.FilterOpts, level []uint8) (*CPNFTLevelSupplyUpdatedIterator, error) {
+
+	var levelRule []interface{}
+	for _, levelItem := range level {
+		levelRule = append(levelRule, levelItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.FilterLogs(opts, "LevelSupplyUpdated", levelRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CPNFTLevelSupplyUpdatedIterator{contract: _CPNFT.contract, event: "LevelSupplyUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchLevelSupplyUpdated is a free log subscription operation binding the contract event 0x58e8ca5f8a3463635e518701d45d8eee43e686dfad74b665ef71b814c0e115c0.
+//
+// Solidity: event LevelSupplyUpdated(uint8 indexed level, uint256 newSupply)
+func (_CPNFT *CPNFTFilterer) WatchLevelSupplyUpdated(opts *bind.WatchOpts, sink chan<- *CPNFTLevelSupplyUpdated, level []uint8) (event.Subscription, error) {
+
+	var levelRule []interface{}
+	for _, levelItem := range level {
+		levelRule = append(levelRule, levelItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.WatchLogs(opts, "LevelSupplyUpdated", levelRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CPNFTLevelSupplyUpdated)
+				if err := _CPNFT.contract.UnpackLog(event, "LevelSupplyUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLevelSupplyUpdated is a log parse operation binding the contract event 0x58e8ca5f8a3463635e518701d45d8eee43e686dfad74b665ef71b814c0e115c0.
+//
+// Solidity: event LevelSupplyUpdated(uint8 indexed level, uint256 newSupply)
+func (_CPNFT *CPNFTFilterer) ParseLevelSupplyUpdated(log types.Log) (*CPNFTLevelSupplyUpdated, error) {
+	event := new(CPNFTLevelSupplyUpdated)
+	if err := _CPNFT.contract.UnpackLog(event, "LevelSupplyUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CPNFTMarketplaceContractSetIterator is returned from FilterMarketplaceContractSet and is used to iterate over the raw logs and unpacked data for MarketplaceContractSet events raised by the CPNFT contract.
+type CPNFTMarketplaceContractSetIterator struct {
+	Event *CPNFTMarketplaceContractSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CPNFTMarketplaceContractSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CPNFTMarketplaceContractSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CPNFTMarketplaceContractSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CPNFTMarketplaceContractSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CPNFTMarketplaceContractSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CPNFTMarketplaceContractSet represents a MarketplaceContractSet event raised by the CPNFT contract.
+type CPNFTMarketplaceContractSet struct {
+	MarketplaceContract common.Address
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterMarketplaceContractSet is a free log retrieval operation binding the contract event 0x27a25c7422f76a6fd1c3d5b5a1af482c3376b5e6f93cd09619e805bfd6ef7670.
+//
+// Solidity: event MarketplaceContractSet(address indexed marketplaceContract)
+func (_CPNFT *CPNFTFilterer) FilterMarketplaceContractSet(opts *bind.FilterOpts, marketplaceContract []common.Address) (*CPNFTMarketplaceContractSetIterator, error) {
+
+	var marketplaceContractRule []interface{}
+	for _, marketplaceContractItem := range marketplaceContract {
+		marketplaceContractRule = append(marketplaceContractRule, marketplaceContractItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.FilterLogs(opts, "MarketplaceContractSet", marketplaceContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CPNFTMarketplaceContractSetIterator{contract: _CPNFT.contract, event: "MarketplaceContractSet", logs: logs, sub: sub}, nil
+}
+
+// WatchMarketplaceContractSet is a free log subscription operation binding the contract event 0x27a25c7422f76a6fd1c3d5b5a1af482c3376b5e6f93cd09619e805bfd6ef7670.
+//
+// Solidity: event MarketplaceContractSet(address indexed marketplaceContract)
+func (_CPNFT *CPNFTFilterer) WatchMarketplaceContractSet(opts *bind.WatchOpts, sink chan<- *CPNFTMarketplaceContractSet, marketplaceContract []common.Address) (event.Subscription, error) {
+
+	var marketplaceContractRule []interface{}
+	for _, marketplaceContractItem := range marketplaceContract {
+		marketplaceContractRule = append(marketplaceContractRule, marketplaceContractItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.WatchLogs(opts, "MarketplaceContractSet", marketplaceContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CPNFTMarketplaceContractSet)
+				if err := _CPNFT.contract.UnpackLog(event, "MarketplaceContractSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMarketplaceContractSet is a log parse operation binding the contract event 0x27a25c7422f76a6fd1c3d5b5a1af482c3376b5e6f93cd09619e805bfd6ef7670.
+//
+// Solidity: event MarketplaceContractSet(address indexed marketplaceContract)
+func (_CPNFT *CPNFTFilterer) ParseMarketplaceContractSet(log types.Log) (*CPNFTMarketplaceContractSet, error) {
+	event := new(CPNFTMarketplaceContractSet)
+	if err := _CPNFT.contract.UnpackLog(event, "MarketplaceContractSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CPNFTOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the CPNFT contract.
+type CPNFTOwnershipTransferredIterator struct {
+	Event *CPNFTOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CPNFTOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CPNFTOwnershipTransferred)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1346,6 +2144,150 @@ func (_CPNFT *CPNFTFilterer) ParseOwnershipTransferred(log types.Log) (*CPNFTOwn
 	return event, nil
 }
 
+// CPNFTStakingContractSetIterator is returned from FilterStakingContractSet and is used to iterate over the raw logs and unpacked data for StakingContractSet events raised by the CPNFT contract.
+type CPNFTStakingContractSetIterator struct {
+	Event *CPNFTStakingContractSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CPNFTStakingContractSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CPNFTStakingContractSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CPNFTStakingContractSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CPNFTStakingContractSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CPNFTStakingContractSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CPNFTStakingContractSet represents a StakingContractSet event raised by the CPNFT contract.
+type CPNFTStakingContractSet struct {
+	StakingContract common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterStakingContractSet is a free log retrieval operation binding the contract event 0x1253844b0fff3da7dd2829de816c9b4f94c238cf2bf6eb72c02c7d6f2b53beac.
+//
+// Solidity: event StakingContractSet(address indexed stakingContract)
+func (_CPNFT *CPNFTFilterer) FilterStakingContractSet(opts *bind.FilterOpts, stakingContract []common.Address) (*CPNFTStakingContractSetIterator, error) {
+
+	var stakingContractRule []interface{}
+	for _, stakingContractItem := range stakingContract {
+		stakingContractRule = append(stakingContractRule, stakingContractItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.FilterLogs(opts, "StakingContractSet", stakingContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CPNFTStakingContractSetIterator{contract: _CPNFT.contract, event: "StakingContractSet", logs: logs, sub: sub}, nil
+}
+
+// WatchStakingContractSet is a free log subscription operation binding the contract event 0x1253844b0fff3da7dd2829de816c9b4f94c238cf2bf6eb72c02c7d6f2b53beac.
+//
+// Solidity: event StakingContractSet(address indexed stakingContract)
+func (_CPNFT *CPNFTFilterer) WatchStakingContractSet(opts *bind.WatchOpts, sink chan<- *CPNFTStakingContractSet, stakingContract []common.Address) (event.Subscription, error) {
+
+	var stakingContractRule []interface{}
+	for _, stakingContractItem := range stakingContract {
+		stakingContractRule = append(stakingContractRule, stakingContractItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.WatchLogs(opts, "StakingContractSet", stakingContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CPNFTStakingContractSet)
+				if err := _CPNFT.contract.UnpackLog(event, "StakingContractSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStakingContractSet is a log parse operation binding the contract event 0x1253844b0fff3da7dd2829de816c9b4f94c238cf2bf6eb72c02c7d6f2b53beac.
+//
+// Solidity: event StakingContractSet(address indexed stakingContract)
+func (_CPNFT *CPNFTFilterer) ParseStakingContractSet(log types.Log) (*CPNFTStakingContractSet, error) {
+	event := new(CPNFTStakingContractSet)
+	if err := _CPNFT.contract.UnpackLog(event, "StakingContractSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // CPNFTTokenLevelSetIterator is returned from FilterTokenLevelSet and is used to iterate over the raw logs and unpacked data for TokenLevelSet events raised by the CPNFT contract.
 type CPNFTTokenLevelSetIterator struct {
 	Event *CPNFTTokenLevelSet // Event containing the contract specifics and raw log
@@ -1797,3 +2739,147 @@ func (_CPNFT *CPNFTFilterer) ParseTransfer(log types.Log) (*CPNFTTransfer, error
 	event.Raw = log
 	return event, nil
 }
+
+// CPNFTUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the CPNFT contract.
+type CPNFTUpgradedIterator struct {
+	Event *CPNFTUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CPNFTUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CPNFTUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CPNFTUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CPNFTUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CPNFTUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CPNFTUpgraded represents a Upgraded event raised by the CPNFT contract.
+type CPNFTUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CPNFT *CPNFTFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*CPNFTUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CPNFTUpgradedIterator{contract: _CPNFT.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CPNFT *CPNFTFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *CPNFTUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _CPNFT.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CPNFTUpgraded)
+				if err := _CPNFT.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CPNFT *CPNFTFilterer) ParseUpgraded(log types.Log) (*CPNFTUpgraded, error) {
+	event := new(CPNFTUpgraded)
+	if err := _CPNFT.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,59 @@
+// Package cpoptest deploys the CPOP contracts into an in-process simulated
+// chain so that Go integration tests can run without a Hardhat node.
+package cpoptest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// ChainID is the chain ID used by every simulated backend.
+var ChainID = big.NewInt(1337)
+
+// DefaultBalance is the genesis balance given to each funded key (1M ether).
+var DefaultBalance = new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(1e18))
+
+// NewBackend starts a simulated chain with each key funded with DefaultBalance
+// and Multicall3 deployed at cpop.Multicall3Address.
+func NewBackend(keys ...*ecdsa.PrivateKey) *simulated.Backend {
+	alloc := types.GenesisAlloc{cpop.Multicall3Address: {Code: multicall3Code}}
+	for _, key := range keys {
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: DefaultBalance}
+	}
+	return simulated.NewBackend(alloc)
+}
+
+// NewTransactor returns transact options signing with key on the simulated chain.
+func NewTransactor(key *ecdsa.PrivateKey) *bind.TransactOpts {
+	auth, err := bind.NewKeyedTransactorWithChainID(key, ChainID)
+	if err != nil {
+		// Only fails on a nil key or chain ID.
+		panic(err)
+	}
+	return auth
+}
+
+// Mine commits a block and checks that every given transaction succeeded.
+func Mine(backend *simulated.Backend, txs ...*types.Transaction) error {
+	backend.Commit()
+	client := backend.Client()
+	for _, tx := range txs {
+		receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil {
+			return fmt.Errorf("receipt of %s: %w", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+		}
+	}
+	return nil
+}
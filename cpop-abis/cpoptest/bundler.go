@@ -0,0 +1,196 @@
+package cpoptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// Bundler error codes of the ERC-4337 RPC specification.
+const (
+	CodeInvalidParams   = -32602
+	CodeRejectedByEP    = -32500
+	CodeExecutionRevert = -32521
+)
+
+// Bundler is a stand-in ERC-4337 bundler serving the eth_* user operation
+// methods over HTTP. Each operation is submitted on its own through
+// EntryPoint.handleOps and mined immediately.
+type Bundler struct {
+	URL string
+
+	// Estimate is returned as is by eth_estimateUserOperationGas.
+	Estimate cpop.UserOpGasEstimate
+
+	backend    *simulated.Backend
+	entryPoint common.Address
+	contract   *cpop.EntryPoint
+	auth       *bind.TransactOpts
+	server     *httptest.Server
+	rpc        *rpc.Server
+
+	mu       sync.Mutex
+	receipts map[common.Hash]*cpop.UserOpReceipt
+}
+
+// NewBundler starts a bundler for the EntryPoint at entryPoint that submits
+// bundles with auth, which also receives the gas refunds.
+func NewBundler(backend *simulated.Backend, entryPoint common.Address, auth *bind.TransactOpts) (*Bundler, error) {
+	contract, err := cpop.NewEntryPoint(entryPoint, backend.Client())
+	if err != nil {
+		return nil, err
+	}
+	b := &Bundler{
+		Estimate: cpop.UserOpGasEstimate{
+			PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+			VerificationGasLimit: (*hexutil.Big)(big.NewInt(500_000)),
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(500_000)),
+		},
+		backend:    backend,
+		entryPoint: entryPoint,
+		contract:   contract,
+		auth:       auth,
+		rpc:        rpc.NewServer(),
+		receipts:   make(map[common.Hash]*cpop.UserOpReceipt),
+	}
+	if err := b.rpc.RegisterName("eth", &bundlerAPI{b}); err != nil {
+		return nil, err
+	}
+	b.server = httptest.NewServer(b.rpc)
+	b.URL = b.server.URL
+	return b, nil
+}
+
+// Client dials the bundler.
+func (b *Bundler) Client(ctx context.Context) (*cpop.BundlerClient, error) {
+	return cpop.DialBundler(ctx, b.URL)
+}
+
+// Close stops the HTTP server.
+func (b *Bundler) Close() {
+	b.server.Close()
+	b.rpc.Stop()
+}
+
+// bundlerError is a JSON-RPC error with revert data.
+type bundlerError struct {
+	code int
+	msg  string
+	data string
+}
+
+func (e *bundlerError) Error() string          { return e.msg }
+func (e *bundlerError) ErrorCode() int         { return e.code }
+func (e *bundlerError) ErrorData() interface{} { return e.data }
+
+// bundlerAPI holds the methods served under the eth namespace.
+type bundlerAPI struct {
+	b *Bundler
+}
+
+// SupportedEntryPoints implements eth_supportedEntryPoints.
+func (api *bundlerAPI) SupportedEntryPoints() []common.Address {
+	return []common.Address{api.b.entryPoint}
+}
+
+// EstimateUserOperationGas implements eth_estimateUserOperationGas.
+func (api *bundlerAPI) EstimateUserOperationGas(op cpop.RPCUserOperation, entryPoint common.Address) (*cpop.UserOpGasEstimate, error) {
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	estimate := api.b.Estimate
+	return &estimate, nil
+}
+
+// GetUserOperationReceipt implements eth_getUserOperationReceipt.
+func (api *bundlerAPI) GetUserOperationReceipt(userOpHash common.Hash) *cpop.UserOpReceipt {
+	api.b.mu.Lock()
+	defer api.b.mu.Unlock()
+	return api.b.receipts[userOpHash]
+}
+
+// SendUserOperation implements eth_sendUserOperation.
+func (api *bundlerAPI) SendUserOperation(ctx context.Context, rpcOp cpop.RPCUserOperation, entryPoint common.Address) (common.Hash, error) {
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	b := api.b
+	op, err := rpcOp.Packed()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	hash, err := b.contract.GetUserOpHash(&bind.CallOpts{Context: ctx}, op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	auth := *b.auth
+	auth.Context = ctx
+	tx, err := b.contract.HandleOps(&auth, []cpop.PackedUserOperation{op}, b.auth.From)
+	if err != nil {
+		return common.Hash{}, rejected(err)
+	}
+	b.backend.Commit()
+	receipt, err := b.backend.Client().TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	out := &cpop.UserOpReceipt{
+		UserOpHash: hash,
+		EntryPoint: b.entryPoint,
+		Sender:     op.Sender,
+		Nonce:      (*hexutil.Big)(op.Nonce),
+		Logs:       receipt.Logs, // one operation per bundle
+		Receipt:    receipt,
+	}
+	for _, log := range receipt.Logs {
+		if log.Address != b.entryPoint || len(log.Topics) < 2 || log.Topics[1] != hash {
+			continue
+		}
+		if ev, err := b.contract.ParseUserOperationEvent(*log); err == nil {
+			out.Paymaster = ev.Paymaster
+			out.Success = ev.Success
+			out.ActualGasCost = (*hexutil.Big)(ev.ActualGasCost)
+			out.ActualGasUsed = (*hexutil.Big)(ev.ActualGasUsed)
+		}
+		if ev, err := b.contract.ParseUserOperationRevertReason(*log); err == nil {
+			out.Reason = ev.RevertReason
+		}
+	}
+	b.receipts[hash] = out
+	return hash, nil
+}
+
+// checkEntryPoint rejects operations for other EntryPoints.
+func (api *bundlerAPI) checkEntryPoint(entryPoint common.Address) error {
+	if entryPoint != api.b.entryPoint {
+		return &bundlerError{code: CodeInvalidParams, msg: fmt.Sprintf("unsupported entry point %s", entryPoint.Hex())}
+	}
+	return nil
+}
+
+// rejected converts a failed handleOps simulation into a bundler error that
+// carries the EntryPoint revert data.
+func rejected(err error) error {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			return &bundlerError{code: CodeRejectedByEP, msg: "user operation rejected by entry point", data: data}
+		}
+	}
+	return &bundlerError{code: CodeRejectedByEP, msg: err.Error()}
+}
@@ -0,0 +1,152 @@
+package cpoptest_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestBundler(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	ownerKey, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs := core.Addresses
+
+	bundler, err := cpoptest.NewBundler(sim, addrs.EntryPoint, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bundler.Close()
+	client, err := bundler.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if eps, err := client.SupportedEntryPoints(ctx); err != nil || len(eps) != 1 || eps[0] != addrs.EntryPoint {
+		t.Fatalf("supported entry points = %v, %v", eps, err)
+	}
+
+	builder, err := cpop.NewUserOpBuilder(sim.Client(), addrs.AccountManager, addrs.EntryPoint, cpoptest.ChainID, cpop.EntryPointV08)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// send builds, estimates, signs and sends an operation of the owner's
+	// account and waits for its receipt.
+	send := func(callData []byte, sign func(common.Hash) ([]byte, error)) (*cpop.UserOpReceipt, error) {
+		t.Helper()
+		op, err := builder.Build(nil, owner, common.Address{}, callData, cpop.UserOpGas{
+			MaxFeePerGas:         big.NewInt(2e9),
+			MaxPriorityFeePerGas: big.NewInt(1e9),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		estimate, err := client.EstimateUserOperationGas(ctx, op, addrs.EntryPoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := estimate.Apply(&op); err != nil {
+			t.Fatal(err)
+		}
+		hash := builder.Hash(op)
+		if op.Signature, err = sign(hash); err != nil {
+			t.Fatal(err)
+		}
+		sent, err := client.SendUserOperation(ctx, op, addrs.EntryPoint)
+		if err != nil {
+			return nil, err
+		}
+		if sent != hash {
+			t.Errorf("bundler hash %s, want %s", sent.Hex(), hash.Hex())
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		return client.WaitForUserOperationReceipt(waitCtx, hash, 10*time.Millisecond)
+	}
+	ownerSig := func(hash common.Hash) ([]byte, error) { return cpop.SignUserOpHash(ownerKey, hash) }
+
+	// The account pays for its operations from its EntryPoint deposit.
+	sender, err := core.AccountManager.GetAccountAddress(nil, owner, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit := *auth
+	deposit.Value = big.NewInt(1e18)
+	tx, err := core.EntryPoint.DepositTo(&deposit, sender)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cpoptest.Mine(sim, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first operation deploys the account.
+	callData, err := cpop.EncodeExecute(owner, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := send(callData, ownerSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !receipt.Success || receipt.Sender != sender || receipt.Receipt == nil {
+		t.Fatalf("receipt = %+v", receipt)
+	}
+	if deployed, err := core.AccountManager.IsAccountDeployed(nil, owner, common.Address{}); err != nil || !deployed {
+		t.Fatalf("account deployed = %v, %v", deployed, err)
+	}
+
+	// A reverting call is included and its reason decoded from the receipt.
+	managerABI, err := cpop.AccountManagerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := managerABI.Pack("createAccount", common.Address{}, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if callData, err = cpop.EncodeExecute(addrs.AccountManager, nil, inner); err != nil {
+		t.Fatal(err)
+	}
+	receipt, err = send(callData, ownerSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Success {
+		t.Fatal("reverting call succeeded")
+	}
+	if got := receipt.RevertReason(); got != "AccountManager: invalid owner" {
+		t.Errorf("revert reason = %q", got)
+	}
+	if revert, ok := receipt.Revert(); !ok || revert.Contract != "AccountManager" || revert.Reason != "invalid owner" {
+		t.Errorf("revert = %v, %v", revert, ok)
+	}
+
+	// An operation the EntryPoint rejects comes back as a bundler error.
+	strangerKey, _ := crypto.GenerateKey()
+	_, err = send(callData, func(hash common.Hash) ([]byte, error) { return cpop.SignUserOpHash(strangerKey, hash) })
+	var bundlerErr *cpop.BundlerError
+	if !errors.As(err, &bundlerErr) || bundlerErr.Code != cpoptest.CodeRejectedByEP {
+		t.Fatalf("err = %v, want a rejected-by-entry-point bundler error", err)
+	}
+	var revert *cpop.RevertError
+	if !errors.As(err, &revert) || revert.Name != "FailedOp" || revert.Reason != "AA24 signature error" {
+		t.Fatalf("err = %v, want FailedOp AA24", err)
+	}
+}
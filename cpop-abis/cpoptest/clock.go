@@ -0,0 +1,212 @@
+package cpoptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// ErrProductionChain is returned when a TestClock is created on the chain of
+// a production manifest.
+var ErrProductionChain = errors.New("refusing to drive the test clock of a production chain")
+
+// TestClockContract is a contract with a test-mode clock, such as
+// *cpop.Staking and *cpop.Marketplace.
+type TestClockContract interface {
+	EnableTestMode(opts *bind.TransactOpts, initialTimestamp *big.Int) (*types.Transaction, error)
+	DisableTestMode(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetTestTimestamp(opts *bind.TransactOpts, timestamp *big.Int) (*types.Transaction, error)
+	GetCurrentTimestamp(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// TestClockBackend is the node access needed by TestClock. It is implemented
+// by the simulated backend client and *ethclient.Client.
+type TestClockBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// TestClockOptions tunes a TestClock.
+type TestClockOptions struct {
+	Start      uint64             // initial test timestamp, the latest block time by default
+	Simulated  *simulated.Backend // mines the clock transactions and moves the block time along
+	Production []*cpop.Manifest   // manifests whose chains are refused besides the embedded production ones
+}
+
+// TestClock drives the test-mode clocks of several contracts in lockstep:
+// every contract is put into test mode at the same timestamp and moved with
+// SetTestTimestamp together. With a simulated backend the block time
+// follows the clock whenever it is ahead of the chain.
+type TestClock struct {
+	backend   TestClockBackend
+	sim       *simulated.Backend
+	owner     *bind.TransactOpts
+	contracts []TestClockContract
+	now       uint64
+}
+
+// NewTestClock enables test mode on contracts, which must all be owned by
+// owner. It fails with ErrProductionChain on the chain of a production
+// manifest.
+func NewTestClock(ctx context.Context, backend TestClockBackend, owner *bind.TransactOpts, opts *TestClockOptions, contracts ...TestClockContract) (*TestClock, error) {
+	if opts == nil {
+		opts = new(TestClockOptions)
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("chain ID: %w", err)
+	}
+	production := cpop.ProductionChainIDs()
+	for _, m := range opts.Production {
+		production = append(production, m.ChainID)
+	}
+	for _, id := range production {
+		if chainID.IsUint64() && chainID.Uint64() == id {
+			return nil, fmt.Errorf("%w: chain %s", ErrProductionChain, chainID)
+		}
+	}
+
+	c := &TestClock{backend: backend, sim: opts.Simulated, owner: owner, now: opts.Start}
+	if c.now == 0 {
+		head, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("latest header: %w", err)
+		}
+		c.now = head.Time
+	}
+	if err := c.Add(ctx, contracts...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Now returns the current test timestamp.
+func (c *TestClock) Now() uint64 {
+	return c.now
+}
+
+// Time returns the current test timestamp as a time.Time.
+func (c *TestClock) Time() time.Time {
+	return time.Unix(int64(c.now), 0)
+}
+
+// Add enables test mode on more contracts at the current test timestamp.
+func (c *TestClock) Add(ctx context.Context, contracts ...TestClockContract) error {
+	err := c.send(ctx, "enable test mode", contracts, func(contract TestClockContract, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.EnableTestMode(opts, new(big.Int).SetUint64(c.now))
+	})
+	if err != nil {
+		return err
+	}
+	c.contracts = append(c.contracts, contracts...)
+	return nil
+}
+
+// Set moves every contract to timestamp, which must not be before Now.
+func (c *TestClock) Set(ctx context.Context, timestamp uint64) error {
+	if timestamp < c.now {
+		return fmt.Errorf("cannot go back in time from %d to %d", c.now, timestamp)
+	}
+	err := c.send(ctx, "set test timestamp", c.contracts, func(contract TestClockContract, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.SetTestTimestamp(opts, new(big.Int).SetUint64(timestamp))
+	})
+	if err != nil {
+		return err
+	}
+	c.now = timestamp
+	if c.sim == nil {
+		return nil
+	}
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("latest header: %w", err)
+	}
+	if timestamp > head.Time {
+		if err := c.sim.AdjustTime(time.Duration(timestamp-head.Time) * time.Second); err != nil {
+			return fmt.Errorf("adjust block time: %w", err)
+		}
+	}
+	return nil
+}
+
+// Advance moves every contract forward by d, rounded down to seconds.
+func (c *TestClock) Advance(ctx context.Context, d time.Duration) error {
+	return c.Set(ctx, c.now+uint64(d/time.Second))
+}
+
+// AdvanceMinutes moves every contract forward like Staking.fastForwardMinutes.
+func (c *TestClock) AdvanceMinutes(ctx context.Context, minutes uint64) error {
+	return c.Set(ctx, c.now+minutes*60)
+}
+
+// AdvanceDays moves every contract forward like Staking.fastForwardDays.
+func (c *TestClock) AdvanceDays(ctx context.Context, days uint64) error {
+	return c.Set(ctx, c.now+days*86400)
+}
+
+// Verify checks that GetCurrentTimestamp of every contract returns Now.
+func (c *TestClock) Verify(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+	for i, contract := range c.contracts {
+		ts, err := contract.GetCurrentTimestamp(opts)
+		if err != nil {
+			return fmt.Errorf("contract %d: current timestamp: %w", i, err)
+		}
+		if !ts.IsUint64() || ts.Uint64() != c.now {
+			return fmt.Errorf("contract %d: clock at %s, want %d", i, ts, c.now)
+		}
+	}
+	return nil
+}
+
+// Close disables test mode on every contract, returning them to block time.
+func (c *TestClock) Close(ctx context.Context) error {
+	err := c.send(ctx, "disable test mode", c.contracts, func(contract TestClockContract, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.DisableTestMode(opts)
+	})
+	if err != nil {
+		return err
+	}
+	c.contracts = nil
+	return nil
+}
+
+// send calls fn on every contract and waits until the transactions are mined
+// and succeeded, committing a block on the simulated backend.
+func (c *TestClock) send(ctx context.Context, step string, contracts []TestClockContract, fn func(TestClockContract, *bind.TransactOpts) (*types.Transaction, error)) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+	opts := *c.owner
+	opts.Context = ctx
+	txs := make([]*types.Transaction, 0, len(contracts))
+	for i, contract := range contracts {
+		tx, err := fn(contract, &opts)
+		if err != nil {
+			return fmt.Errorf("%s on contract %d: %w", step, i, cpop.AsRevertError(err))
+		}
+		txs = append(txs, tx)
+	}
+	if c.sim != nil {
+		c.sim.Commit()
+	}
+	for _, tx := range txs {
+		receipt, err := bind.WaitMined(ctx, c.backend, tx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", step, err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("%s: transaction %s reverted", step, tx.Hash().Hex())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package cpoptest
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// CoreOptions tunes the deployed core stack. Zero values fall back to the
+// parameters used by scripts/deploy-core-bnb.ts.
+type CoreOptions struct {
+	InitialSupply           *big.Int         // CPOPToken supply minted to the deployer, 1e9 CPOP by default
+	MaxPriceAge             *big.Int         // GasPriceOracle max price age, 3600 seconds by default
+	PriceDeviationThreshold *big.Int         // GasPriceOracle deviation threshold, 500 bps by default
+	FallbackExchangeRate    *big.Int         // GasPaymaster fallback rate, 1e18 by default
+	MasterSigner            common.Address   // Default master signer, the deployer by default
+	Creators                []common.Address // Extra addresses allowed to create accounts
+}
+
+// CoreAddresses lists the addresses of a deployed core stack, using the
+// contract names of deployments/<network>/core.json.
+type CoreAddresses struct {
+	EntryPoint        common.Address
+	CPPToken          common.Address
+	GasPriceOracle    common.Address
+	MasterAggregator  common.Address
+	SessionKeyManager common.Address
+	AccountManager    common.Address
+	GasPaymaster      common.Address
+}
+
+// Core is a fully wired CPP core stack living in a simulated backend.
+type Core struct {
+	Backend   *simulated.Backend
+	Deployer  *bind.TransactOpts
+	Addresses CoreAddresses
+
+	EntryPoint        *cpop.EntryPoint
+	CPOPToken         *cpop.CPOPToken
+	GasPriceOracle    *cpop.GasPriceOracle
+	MasterAggregator  *cpop.MasterAggregator
+	SessionKeyManager *cpop.SessionKeyManager
+	AccountManager    *cpop.AccountManager
+	GasPaymaster      *cpop.GasPaymaster
+}
+
+func (o *CoreOptions) withDefaults(deployer common.Address) CoreOptions {
+	out := CoreOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.InitialSupply == nil {
+		out.InitialSupply = new(big.Int).Mul(big.NewInt(1e9), big.NewInt(1e18))
+	}
+	if out.MaxPriceAge == nil {
+		out.MaxPriceAge = big.NewInt(3600)
+	}
+	if out.PriceDeviationThreshold == nil {
+		out.PriceDeviationThreshold = big.NewInt(500)
+	}
+	if out.FallbackExchangeRate == nil {
+		out.FallbackExchangeRate = big.NewInt(1e18)
+	}
+	if out.MasterSigner == (common.Address{}) {
+		out.MasterSigner = deployer
+	}
+	return out
+}
+
+// DeployCore deploys EntryPoint, CPOPToken, GasPriceOracle, MasterAggregator,
+// SessionKeyManager, AccountManager and GasPaymaster into backend and wires
+// them the way the core.json deployments are: the master signer is set as
+// default on AccountManager and authorized on MasterAggregator and
+// SessionKeyManager, the aggregator is set on AccountManager, the extra
+// creators are authorized and the paymaster is granted MINTER_ROLE.
+//
+// The deployer owns every contract and must be funded in backend.
+func DeployCore(backend *simulated.Backend, deployer *bind.TransactOpts, opts *CoreOptions) (*Core, error) {
+	cfg := opts.withDefaults(deployer.From)
+	client := backend.Client()
+	core := &Core{Backend: backend, Deployer: deployer}
+	addrs := &core.Addresses
+
+	// Contracts without dependencies and the UUPS implementations.
+	var (
+		txs                                              []*types.Transaction
+		oracleImpl, aggregatorImpl, skmImpl, managerImpl common.Address
+		tx                                               *types.Transaction
+		err                                              error
+	)
+	if addrs.EntryPoint, tx, core.EntryPoint, err = cpop.DeployEntryPoint(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy EntryPoint: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.CPPToken, tx, core.CPOPToken, err = cpop.DeployCPOPToken(deployer, client, deployer.From, cfg.InitialSupply); err != nil {
+		return nil, fmt.Errorf("deploy CPOPToken: %w", err)
+	}
+	txs = append(txs, tx)
+	if oracleImpl, tx, _, err = cpop.DeployGasPriceOracle(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy GasPriceOracle: %w", err)
+	}
+	txs = append(txs, tx)
+	if aggregatorImpl, tx, _, err = cpop.DeployMasterAggregator(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy MasterAggregator: %w", err)
+	}
+	txs = append(txs, tx)
+	if skmImpl, tx, _, err = cpop.DeploySessionKeyManager(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy SessionKeyManager: %w", err)
+	}
+	txs = append(txs, tx)
+	if managerImpl, tx, _, err = cpop.DeployAccountManager(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy AccountManager: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	// Proxies, initialized against the contracts mined above.
+	txs = txs[:0]
+	if addrs.GasPriceOracle, tx, core.GasPriceOracle, err = cpop.DeployGasPriceOracleProxy(deployer, client, oracleImpl, deployer.From, cfg.MaxPriceAge, cfg.PriceDeviationThreshold); err != nil {
+		return nil, fmt.Errorf("deploy GasPriceOracle proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.MasterAggregator, tx, core.MasterAggregator, err = cpop.DeployMasterAggregatorProxy(deployer, client, aggregatorImpl, deployer.From, []common.Address{cfg.MasterSigner}); err != nil {
+		return nil, fmt.Errorf("deploy MasterAggregator proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.SessionKeyManager, tx, core.SessionKeyManager, err = cpop.DeploySessionKeyManagerProxy(deployer, client, skmImpl, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy SessionKeyManager proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.AccountManager, tx, core.AccountManager, err = cpop.DeployAccountManagerProxy(deployer, client, managerImpl, addrs.EntryPoint, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy AccountManager proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	// GasPaymaster needs the oracle proxy address.
+	if addrs.GasPaymaster, tx, core.GasPaymaster, err = cpop.DeployGasPaymaster(deployer, client, addrs.EntryPoint, addrs.CPPToken, addrs.GasPriceOracle, cfg.FallbackExchangeRate, false, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy GasPaymaster: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return nil, err
+	}
+
+	if err := core.wire(cfg); err != nil {
+		return nil, err
+	}
+	return core, nil
+}
+
+// wire applies the post-deployment configuration of the core stack.
+func (c *Core) wire(cfg CoreOptions) error {
+	minter, err := c.CPOPToken.MINTERROLE(nil)
+	if err != nil {
+		return fmt.Errorf("read MINTER_ROLE: %w", err)
+	}
+
+	var txs []*types.Transaction
+	send := func(step string, tx *types.Transaction, err error) error {
+		if err != nil {
+			return fmt.Errorf("%s: %w", step, err)
+		}
+		txs = append(txs, tx)
+		return nil
+	}
+	tx, err := c.AccountManager.SetMasterAggregator(c.Deployer, c.Addresses.MasterAggregator)
+	if err := send("set master aggregator", tx, err); err != nil {
+		return err
+	}
+	tx, err = c.AccountManager.SetDefaultMasterSigner(c.Deployer, cfg.MasterSigner)
+	if err := send("set default master signer", tx, err); err != nil {
+		return err
+	}
+	tx, err = c.SessionKeyManager.AuthorizeMasterSigner(c.Deployer, cfg.MasterSigner)
+	if err := send("authorize session master signer", tx, err); err != nil {
+		return err
+	}
+	for _, creator := range cfg.Creators {
+		if creator == c.Deployer.From {
+			// The owner is authorized by AccountManager.initialize.
+			continue
+		}
+		tx, err = c.AccountManager.AuthorizeCreator(c.Deployer, creator)
+		if err := send("authorize creator "+creator.Hex(), tx, err); err != nil {
+			return err
+		}
+	}
+	tx, err = c.CPOPToken.GrantRole(c.Deployer, c.Addresses.GasPaymaster, minter)
+	if err := send("grant paymaster MINTER_ROLE", tx, err); err != nil {
+		return err
+	}
+	return Mine(c.Backend, txs...)
+}
@@ -0,0 +1,109 @@
+package cpoptest_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestDeployCore(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	master := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	creator := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	core, err := cpoptest.DeployCore(sim, cpoptest.NewTransactor(key), &cpoptest.CoreOptions{
+		MasterSigner: master,
+		Creators:     []common.Address{creator},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs := core.Addresses
+
+	// Every contract of the stack is named as in core.json and has code.
+	manifest, err := cpop.LoadManifest("opbnbTestnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := reflect.ValueOf(addrs)
+	for i := 0; i < fields.NumField(); i++ {
+		name := fields.Type().Field(i).Name
+		if _, ok := manifest.Address(name); !ok {
+			t.Errorf("%s is not in the opbnbTestnet manifest", name)
+		}
+		code, err := sim.Client().CodeAt(context.Background(), fields.Field(i).Interface().(common.Address), nil)
+		if err != nil || len(code) == 0 {
+			t.Errorf("%s has no code: %v", name, err)
+		}
+	}
+
+	check := func(what string, got, want interface{}, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", what, err)
+		}
+		if got != want {
+			t.Errorf("%s = %v, want %v", what, got, want)
+		}
+	}
+	got, err := core.AccountManager.GetDefaultMasterSigner(nil)
+	check("default master signer", got, master, err)
+	got, err = core.AccountManager.MasterAggregatorAddress(nil)
+	check("master aggregator", got, addrs.MasterAggregator, err)
+	got, err = core.AccountManager.EntryPointAddress(nil)
+	check("entry point", got, addrs.EntryPoint, err)
+	ok, err := core.MasterAggregator.AuthorizedMasters(nil, master)
+	check("aggregator master authorized", ok, true, err)
+	ok, err = core.SessionKeyManager.AuthorizedMasters(nil, master)
+	check("session master authorized", ok, true, err)
+	for _, c := range []common.Address{core.Deployer.From, creator} {
+		ok, err = core.AccountManager.IsAuthorizedCreator(nil, c)
+		check("creator "+c.Hex()+" authorized", ok, true, err)
+	}
+	ok, err = core.AccountManager.IsAuthorizedCreator(nil, master)
+	check("master authorized as creator", ok, false, err)
+
+	minter, err := core.CPOPToken.MINTERROLE(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err = core.CPOPToken.HasRole(nil, addrs.GasPaymaster, minter)
+	check("paymaster MINTER_ROLE", ok, true, err)
+	got, err = core.GasPaymaster.Oracle(nil)
+	check("paymaster oracle", got, addrs.GasPriceOracle, err)
+	got, err = core.GasPaymaster.Token(nil)
+	check("paymaster token", got, addrs.CPPToken, err)
+}
+
+func TestDeployCoreDefaults(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+
+	core, err := cpoptest.DeployCore(sim, cpoptest.NewTransactor(key), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	master, err := core.AccountManager.GetDefaultMasterSigner(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if master != core.Deployer.From {
+		t.Errorf("default master signer = %s, want the deployer %s", master.Hex(), core.Deployer.From.Hex())
+	}
+	supply, err := core.CPOPToken.BalanceOf(nil, core.Deployer.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "1000000000000000000000000000"; supply.String() != want {
+		t.Errorf("deployer balance = %s, want %s", supply, want)
+	}
+}
@@ -0,0 +1,122 @@
+package cpoptest
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// EarnAddresses lists the addresses of a deployed Chapool Earn stack.
+type EarnAddresses struct {
+	USDT               common.Address
+	CPOT               common.Address
+	CPNFT              common.Address
+	ChapoolEarnVault   common.Address
+	VeCPOTLocker       common.Address
+	NFTBoostController common.Address
+}
+
+// EarnStack is ChapoolEarnVault with its veCPOT and NFT boosts, wired to a
+// Core and living in its simulated backend.
+type EarnStack struct {
+	Addresses EarnAddresses
+
+	USDT               *cpop.MockUSDT
+	CPOT               *cpop.MockUSDT
+	CPNFT              *cpop.CPNFT
+	ChapoolEarnVault   *cpop.ChapoolEarnVault
+	VeCPOTLocker       *cpop.VeCPOTLocker
+	NFTBoostController *cpop.NFTBoostController
+}
+
+// DeployEarn deploys ChapoolEarnVault, VeCPOTLocker and NFTBoostController
+// behind their proxies next to core, owned by the core deployer, together
+// with CPNFT and two MockUSDT tokens standing in for USDT and CPOT. The vault
+// uses both boosts, the locker notifies the vault, and the vault is granted
+// MINTER_ROLE on CPOPToken to pay CPP to the AccountManager accounts of the
+// depositors. The reward rate is left at zero.
+func DeployEarn(core *Core) (*EarnStack, error) {
+	backend, deployer := core.Backend, core.Deployer
+	client := backend.Client()
+	e := new(EarnStack)
+	addrs := &e.Addresses
+
+	var err error
+	if addrs.CPNFT, e.CPNFT, err = DeployCPNFT(backend, deployer); err != nil {
+		return nil, err
+	}
+	var (
+		txs                              []*types.Transaction
+		vaultImpl, lockerImpl, boostImpl common.Address
+		tx                               *types.Transaction
+	)
+	if addrs.USDT, tx, e.USDT, err = cpop.DeployMockUSDT(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy USDT: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.CPOT, tx, e.CPOT, err = cpop.DeployMockUSDT(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy CPOT: %w", err)
+	}
+	txs = append(txs, tx)
+	if vaultImpl, tx, _, err = cpop.DeployChapoolEarnVault(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy ChapoolEarnVault: %w", err)
+	}
+	txs = append(txs, tx)
+	if lockerImpl, tx, _, err = cpop.DeployVeCPOTLocker(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy VeCPOTLocker: %w", err)
+	}
+	txs = append(txs, tx)
+	if boostImpl, tx, _, err = cpop.DeployNFTBoostController(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy NFTBoostController: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	txs = txs[:0]
+	if addrs.ChapoolEarnVault, tx, e.ChapoolEarnVault, err = cpop.DeployChapoolEarnVaultProxy(deployer, client, vaultImpl, addrs.USDT, core.Addresses.CPPToken, core.Addresses.AccountManager, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy ChapoolEarnVault proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.VeCPOTLocker, tx, e.VeCPOTLocker, err = cpop.DeployVeCPOTLockerProxy(deployer, client, lockerImpl, addrs.CPOT, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy VeCPOTLocker proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if addrs.NFTBoostController, tx, e.NFTBoostController, err = cpop.DeployNFTBoostControllerProxy(deployer, client, boostImpl, addrs.CPNFT, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy NFTBoostController proxy: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	minter, err := core.CPOPToken.MINTERROLE(nil)
+	if err != nil {
+		return nil, fmt.Errorf("read MINTER_ROLE: %w", err)
+	}
+	txs = txs[:0]
+	if tx, err = e.ChapoolEarnVault.SetVecpotLocker(deployer, addrs.VeCPOTLocker); err != nil {
+		return nil, fmt.Errorf("set veCPOT locker: %w", err)
+	}
+	txs = append(txs, tx)
+	if tx, err = e.ChapoolEarnVault.SetNftBoostController(deployer, addrs.NFTBoostController); err != nil {
+		return nil, fmt.Errorf("set NFT boost controller: %w", err)
+	}
+	txs = append(txs, tx)
+	if tx, err = e.VeCPOTLocker.SetVault(deployer, addrs.ChapoolEarnVault); err != nil {
+		return nil, fmt.Errorf("set locker vault: %w", err)
+	}
+	txs = append(txs, tx)
+	if tx, err = core.CPOPToken.GrantRole(deployer, addrs.ChapoolEarnVault, minter); err != nil {
+		return nil, fmt.Errorf("grant vault MINTER_ROLE: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
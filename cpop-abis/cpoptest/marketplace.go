@@ -0,0 +1,78 @@
+package cpoptest
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// MarketAddresses lists the addresses of a deployed Marketplace and the
+// tokens it trades.
+type MarketAddresses struct {
+	CPNFT       common.Address
+	MockUSDT    common.Address
+	Marketplace common.Address
+}
+
+// Market is a Marketplace trading CPNFT tokens for MockUSDT in a
+// simulated backend.
+type Market struct {
+	Addresses MarketAddresses
+
+	CPNFT       *cpop.CPNFT
+	MockUSDT    *cpop.MockUSDT
+	Marketplace *cpop.Marketplace
+}
+
+// DeployMarket deploys CPNFT behind its proxy and MockUSDT owned by
+// tokenOwner, and a Marketplace proxy owned by owner, which also receives the
+// 2.5% platform fee. Sellers may cancel 5 listings a day. The Marketplace is
+// set on CPNFT so that it may escrow tokens.
+//
+// Both accounts must be funded in backend.
+func DeployMarket(backend *simulated.Backend, tokenOwner, owner *bind.TransactOpts) (*Market, error) {
+	client := backend.Client()
+	m := new(Market)
+	addrs := &m.Addresses
+
+	var (
+		txs        []*types.Transaction
+		marketImpl common.Address
+		tx         *types.Transaction
+		err        error
+	)
+	if addrs.CPNFT, m.CPNFT, err = DeployCPNFT(backend, tokenOwner); err != nil {
+		return nil, err
+	}
+	if addrs.MockUSDT, tx, m.MockUSDT, err = cpop.DeployMockUSDT(tokenOwner, client); err != nil {
+		return nil, fmt.Errorf("deploy MockUSDT: %w", err)
+	}
+	txs = append(txs, tx)
+	if marketImpl, tx, _, err = cpop.DeployMarketplace(owner, client); err != nil {
+		return nil, fmt.Errorf("deploy Marketplace: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	if addrs.Marketplace, tx, m.Marketplace, err = cpop.DeployMarketplaceProxy(owner, client, marketImpl, addrs.CPNFT, addrs.MockUSDT, owner.From, big.NewInt(250), big.NewInt(5), big.NewInt(86400), owner.From); err != nil {
+		return nil, fmt.Errorf("deploy Marketplace proxy: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return nil, err
+	}
+	if tx, err = m.CPNFT.SetMarketplaceContract(tokenOwner, addrs.Marketplace); err != nil {
+		return nil, fmt.Errorf("set marketplace contract: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,44 @@
+package cpoptest_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestDeployMarket(t *testing.T) {
+	tokenKey, _ := crypto.GenerateKey()
+	marketKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(tokenKey, marketKey)
+	defer sim.Close()
+	tokenOwner, owner := cpoptest.NewTransactor(tokenKey), cpoptest.NewTransactor(marketKey)
+
+	m, err := cpoptest.DeployMarket(sim, tokenOwner, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs := m.Addresses
+	for _, c := range []struct {
+		name string
+		got  func() (common.Address, error)
+		want common.Address
+	}{
+		{name: "CPNFT owner", got: func() (common.Address, error) { return m.CPNFT.Owner(nil) }, want: tokenOwner.From},
+		{name: "CPNFT marketplace", got: func() (common.Address, error) { return m.CPNFT.MarketplaceContract(nil) }, want: addrs.Marketplace},
+		{name: "MockUSDT owner", got: func() (common.Address, error) { return m.MockUSDT.Owner(nil) }, want: tokenOwner.From},
+		{name: "Marketplace owner", got: func() (common.Address, error) { return m.Marketplace.Owner(nil) }, want: owner.From},
+		{name: "Marketplace fee recipient", got: func() (common.Address, error) { return m.Marketplace.PlatformFeeRecipient(nil) }, want: owner.From},
+		{name: "Marketplace NFT", got: func() (common.Address, error) { return m.Marketplace.NftContract(nil) }, want: addrs.CPNFT},
+		{name: "Marketplace payment token", got: func() (common.Address, error) { return m.Marketplace.PaymentToken(nil) }, want: addrs.MockUSDT},
+	} {
+		if got, err := c.got(); err != nil || got != c.want {
+			t.Errorf("%s = %s, %v; want %s", c.name, got.Hex(), err, c.want.Hex())
+		}
+	}
+	if limit, err := m.Marketplace.DelistingLimit(nil); err != nil || limit.Int64() != 5 {
+		t.Errorf("delisting limit %v, %v; want 5", limit, err)
+	}
+}
@@ -0,0 +1,17 @@
+package cpoptest
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// multicall3Bin is the runtime code of Multicall3.sol in hex.
+//
+//go:embed Multicall3.runtime.bin
+var multicall3Bin string
+
+// multicall3Code is placed at cpop.Multicall3Address in the genesis of every
+// simulated backend so that cpop.NewMulticall works as on BSC.
+var multicall3Code = common.FromHex(strings.TrimSpace(multicall3Bin))
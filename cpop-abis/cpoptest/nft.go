@@ -0,0 +1,32 @@
+package cpoptest
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// DeployCPNFT deploys the CPNFT implementation and an initialized proxy in
+// front of it, owned by owner, and returns the proxy.
+func DeployCPNFT(backend *simulated.Backend, owner *bind.TransactOpts) (common.Address, *cpop.CPNFT, error) {
+	client := backend.Client()
+	impl, tx, _, err := cpop.DeployCPNFT(owner, client)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("deploy CPNFT: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return common.Address{}, nil, err
+	}
+	addr, tx, nft, err := cpop.DeployCPNFTProxy(owner, client, impl, "CPNFT", "CPNFT", "https://example.com/")
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("deploy CPNFT proxy: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return common.Address{}, nil, err
+	}
+	return addr, nft, nil
+}
@@ -0,0 +1,56 @@
+package cpoptest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// PriceSource is a stand-in cpop.PriceSource answering with the prices set
+// by the test.
+type PriceSource struct {
+	name string
+
+	mu     sync.Mutex
+	prices map[string]*big.Int
+	err    error
+}
+
+// NewPriceSource returns a source named name with no prices.
+func NewPriceSource(name string) *PriceSource {
+	return &PriceSource{name: name, prices: make(map[string]*big.Int)}
+}
+
+// Name implements cpop.PriceSource.
+func (s *PriceSource) Name() string {
+	return s.name
+}
+
+// Price implements cpop.PriceSource.
+func (s *PriceSource) Price(ctx context.Context, feed string) (*big.Int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	price, ok := s.prices[feed]
+	if !ok {
+		return nil, fmt.Errorf("no %s price", feed)
+	}
+	return new(big.Int).Set(price), nil
+}
+
+// Set sets the price of feed.
+func (s *PriceSource) Set(feed string, price *big.Int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prices[feed] = new(big.Int).Set(price)
+}
+
+// Fail makes Price return err until Fail(nil) is called.
+func (s *PriceSource) Fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
@@ -0,0 +1,96 @@
+package cpoptest
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// StakingAddresses lists the addresses of a deployed staking stack.
+type StakingAddresses struct {
+	CPNFT         common.Address
+	StakingConfig common.Address
+	Staking       common.Address
+	StakingReader common.Address
+}
+
+// StakingStack is CPNFT staking wired to a Core, living in its simulated
+// backend.
+type StakingStack struct {
+	Addresses StakingAddresses
+
+	CPNFT         *cpop.CPNFT
+	StakingConfig *cpop.StakingConfig
+	Staking       *cpop.Staking
+	StakingReader *cpop.StakingReader
+}
+
+// DeployStaking deploys CPNFT, StakingConfig, Staking and StakingReader next
+// to core, owned by the core deployer. Staking is set as the staking contract
+// of CPNFT and granted MINTER_ROLE on CPOPToken, so that it can pay rewards
+// to the AccountManager accounts of the stakers.
+func DeployStaking(core *Core) (*StakingStack, error) {
+	backend, deployer := core.Backend, core.Deployer
+	client := backend.Client()
+	s := new(StakingStack)
+	addrs := &s.Addresses
+
+	var err error
+	if addrs.CPNFT, s.CPNFT, err = DeployCPNFT(backend, deployer); err != nil {
+		return nil, err
+	}
+	var (
+		txs                     []*types.Transaction
+		stakingImpl, readerImpl common.Address
+		tx                      *types.Transaction
+	)
+	if addrs.StakingConfig, tx, s.StakingConfig, err = cpop.DeployStakingConfig(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy StakingConfig: %w", err)
+	}
+	txs = append(txs, tx)
+	if stakingImpl, tx, _, err = cpop.DeployStaking(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy Staking: %w", err)
+	}
+	txs = append(txs, tx)
+	if readerImpl, tx, _, err = cpop.DeployStakingReader(deployer, client); err != nil {
+		return nil, fmt.Errorf("deploy StakingReader: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+
+	if addrs.Staking, tx, s.Staking, err = cpop.DeployStakingProxy(deployer, client, stakingImpl, addrs.CPNFT, core.Addresses.CPPToken, core.Addresses.AccountManager, addrs.StakingConfig, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy Staking proxy: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return nil, err
+	}
+	if addrs.StakingReader, tx, s.StakingReader, err = cpop.DeployStakingReaderProxy(deployer, client, readerImpl, addrs.Staking, addrs.StakingConfig, addrs.CPNFT, deployer.From); err != nil {
+		return nil, fmt.Errorf("deploy StakingReader proxy: %w", err)
+	}
+	if err := Mine(backend, tx); err != nil {
+		return nil, err
+	}
+
+	minter, err := core.CPOPToken.MINTERROLE(nil)
+	if err != nil {
+		return nil, fmt.Errorf("read MINTER_ROLE: %w", err)
+	}
+	txs = txs[:0]
+	if tx, err = s.CPNFT.SetStakingContract(deployer, addrs.Staking); err != nil {
+		return nil, fmt.Errorf("set staking contract: %w", err)
+	}
+	txs = append(txs, tx)
+	if tx, err = core.CPOPToken.GrantRole(deployer, addrs.Staking, minter); err != nil {
+		return nil, fmt.Errorf("grant staking MINTER_ROLE: %w", err)
+	}
+	txs = append(txs, tx)
+	if err := Mine(backend, txs...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,196 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrDelistingLimitExceeded is returned for cancellations that would revert
+// with "Delisting limit exceeded".
+var ErrDelistingLimitExceeded = errors.New("delisting limit exceeded")
+
+// DelistingQuota is the delisting allowance of a seller in the current
+// Marketplace delisting window.
+type DelistingQuota struct {
+	Seller      common.Address `json:"seller"`
+	Limit       uint64         `json:"limit"`         // DelistingLimit
+	Window      uint64         `json:"windowSeconds"` // DelistingWindow
+	Used        uint64         `json:"used"`          // cancellations in the current window
+	Remaining   uint64         `json:"remaining"`
+	WindowStart uint64         `json:"windowStart"` // zero when no window is open
+	ResetsAt    uint64         `json:"resetsAt"`    // zero when no window is open
+	CheckedAt   uint64         `json:"checkedAt"`   // Marketplace time of the evaluation
+}
+
+// NewDelistingQuota evaluates a DelistingRecords entry at Marketplace time now
+// the way Marketplace._checkDelistingLimit does: once the window has passed,
+// the count is reset and the next cancellation opens a new window.
+func NewDelistingQuota(seller common.Address, count, lastResetTime, limit, window, now uint64) *DelistingQuota {
+	q := &DelistingQuota{Seller: seller, Limit: limit, Window: window, CheckedAt: now}
+	if now >= lastResetTime+window {
+		q.Remaining = limit
+		return q
+	}
+	q.Used, q.WindowStart, q.ResetsAt = count, lastResetTime, lastResetTime+window
+	if count < limit {
+		q.Remaining = limit - count
+	}
+	return q
+}
+
+// At returns q evaluated at the later Marketplace time now, without reading
+// the chain.
+func (q *DelistingQuota) At(now uint64) *DelistingQuota {
+	return NewDelistingQuota(q.Seller, q.Used, q.WindowStart, q.Limit, q.Window, now)
+}
+
+// Check returns ErrDelistingLimitExceeded if the seller cannot cancel a
+// listing now.
+func (q *DelistingQuota) Check() error {
+	if q.Remaining == 0 {
+		return fmt.Errorf("%w: %s used %d of %d, resets at %d", ErrDelistingLimitExceeded, q.Seller.Hex(), q.Used, q.Limit, q.ResetsAt)
+	}
+	return nil
+}
+
+// Consume returns the quota after one cancellation at Marketplace time now.
+func (q *DelistingQuota) Consume(now uint64) (*DelistingQuota, error) {
+	q = q.At(now)
+	if err := q.Check(); err != nil {
+		return nil, err
+	}
+	if q.WindowStart == 0 {
+		q.WindowStart, q.ResetsAt = now, now+q.Window
+	}
+	q.Used++
+	q.Remaining--
+	return q, nil
+}
+
+// DelistingManager tracks the delisting quota of sellers and refuses
+// Marketplace.cancelListing calls that would exceed it.
+//
+// Quota, Quotas and Refresh read the state selected by their opts.
+// CancelListing reads the pending state, so cancellations sent but not yet
+// mined already count against the quota it checks.
+type DelistingManager struct {
+	Contract *Marketplace
+
+	mu     sync.Mutex
+	quotas map[common.Address]*DelistingQuota
+}
+
+// NewDelistingManager binds the Marketplace at address.
+func NewDelistingManager(address common.Address, backend bind.ContractBackend) (*DelistingManager, error) {
+	contract, err := NewMarketplace(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DelistingManager{Contract: contract, quotas: make(map[common.Address]*DelistingQuota)}, nil
+}
+
+// Quota reads the current quota of seller and tracks it.
+func (m *DelistingManager) Quota(opts *bind.CallOpts, seller common.Address) (*DelistingQuota, error) {
+	quotas, err := m.Quotas(opts, seller)
+	if err != nil {
+		return nil, err
+	}
+	return quotas[0], nil
+}
+
+// Quotas reads the current quotas of sellers, in order, and tracks them.
+func (m *DelistingManager) Quotas(opts *bind.CallOpts, sellers ...common.Address) ([]*DelistingQuota, error) {
+	limit, err := m.Contract.DelistingLimit(opts)
+	if err != nil {
+		return nil, fmt.Errorf("delisting limit: %w", err)
+	}
+	window, err := m.Contract.DelistingWindow(opts)
+	if err != nil {
+		return nil, fmt.Errorf("delisting window: %w", err)
+	}
+	now, err := m.Contract.GetCurrentTimestamp(opts)
+	if err != nil {
+		return nil, fmt.Errorf("current timestamp: %w", err)
+	}
+	quotas := make([]*DelistingQuota, len(sellers))
+	for i, seller := range sellers {
+		record, err := m.Contract.DelistingRecords(opts, seller)
+		if err != nil {
+			return nil, fmt.Errorf("delisting record of %s: %w", seller.Hex(), err)
+		}
+		quotas[i] = NewDelistingQuota(seller, bigOrZero(record.Count).Uint64(), bigOrZero(record.LastResetTime).Uint64(), limit.Uint64(), window.Uint64(), now.Uint64())
+	}
+	m.mu.Lock()
+	for _, q := range quotas {
+		m.quotas[q.Seller] = q
+	}
+	m.mu.Unlock()
+	return quotas, nil
+}
+
+// Refresh rereads the quotas of all tracked sellers.
+func (m *DelistingManager) Refresh(opts *bind.CallOpts) ([]*DelistingQuota, error) {
+	return m.Quotas(opts, m.sellers()...)
+}
+
+// Tracked returns the last read quotas evaluated at Marketplace time now,
+// ordered by seller.
+func (m *DelistingManager) Tracked(now uint64) []*DelistingQuota {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	quotas := make([]*DelistingQuota, 0, len(m.quotas))
+	for _, q := range m.quotas {
+		quotas = append(quotas, q.At(now))
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].Seller.Cmp(quotas[j].Seller) < 0 })
+	return quotas
+}
+
+// sellers returns the tracked sellers.
+func (m *DelistingManager) sellers() []common.Address {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sellers := make([]common.Address, 0, len(m.quotas))
+	for seller := range m.quotas {
+		sellers = append(sellers, seller)
+	}
+	return sellers
+}
+
+// CancelListing sends Marketplace.cancelListing with auth, which must be the
+// Marketplace owner, unless the listing is not active or its seller has no
+// delisting quota left.
+func (m *DelistingManager) CancelListing(auth *bind.TransactOpts, listingID *big.Int) (*types.Transaction, error) {
+	opts := &bind.CallOpts{Pending: true, Context: auth.Context}
+	listing, err := m.Contract.GetListing(opts, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", listingID, AsRevertError(err))
+	}
+	if status := ListingStatus(listing.Status); status != ListingActive {
+		return nil, fmt.Errorf("%w: listing %s is %s", ErrListingNotActive, listingID, status)
+	}
+	q, err := m.Quota(opts, listing.Seller)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.Check(); err != nil {
+		return nil, err
+	}
+	tx, err := m.Contract.CancelListing(auth, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("cancel listing %s: %w", listingID, AsRevertError(err))
+	}
+	if next, err := q.Consume(q.CheckedAt); err == nil {
+		m.mu.Lock()
+		m.quotas[q.Seller] = next
+		m.mu.Unlock()
+	}
+	return tx, nil
+}
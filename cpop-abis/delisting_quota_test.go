@@ -0,0 +1,184 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestDelistingQuotaWindow(t *testing.T) {
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	// No window open: the first cancellation opens one.
+	q := cpop.NewDelistingQuota(seller, 0, 0, 2, 100, 1000)
+	if q.Remaining != 2 || q.WindowStart != 0 || q.ResetsAt != 0 {
+		t.Fatalf("fresh quota %+v", q)
+	}
+	q, err := q.Consume(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Used != 1 || q.Remaining != 1 || q.WindowStart != 1000 || q.ResetsAt != 1100 {
+		t.Fatalf("after one cancellation %+v", q)
+	}
+	if q, err = q.Consume(1050); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := q.Consume(1099); !errors.Is(err, cpop.ErrDelistingLimitExceeded) {
+		t.Fatalf("third cancellation in the window: %v", err)
+	}
+	// The window is over at lastResetTime + window, not after it.
+	if r := q.At(1100); r.Used != 0 || r.Remaining != 2 || r.WindowStart != 0 || r.ResetsAt != 0 || r.CheckedAt != 1100 {
+		t.Fatalf("at the end of the window %+v", r)
+	}
+	if q, err = q.Consume(1100); err != nil {
+		t.Fatal(err)
+	}
+	if q.Used != 1 || q.WindowStart != 1100 || q.ResetsAt != 1200 {
+		t.Fatalf("after the reset %+v", q)
+	}
+	// Records over the limit, e.g. after the limit was lowered, have
+	// nothing remaining.
+	if q := cpop.NewDelistingQuota(seller, 5, 1000, 3, 100, 1010); q.Remaining != 0 || q.Check() == nil {
+		t.Fatalf("quota over the limit %+v", q)
+	}
+}
+
+// TestDelistingManagerContract cancels listings through DelistingManager and
+// checks the quotas predicted by Consume against Marketplace.cancelListing
+// and getDelistingRecord, across a window reset.
+func TestDelistingManagerContract(t *testing.T) {
+	ctx := context.Background()
+	tokenKey, _ := crypto.GenerateKey()
+	marketKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(tokenKey, marketKey)
+	defer sim.Close()
+	tokenOwner, owner := cpoptest.NewTransactor(tokenKey), cpoptest.NewTransactor(marketKey)
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	m, err := cpoptest.DeployMarket(sim, tokenOwner, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	market := m.Marketplace
+	mine := func(tx interface{ Hash() common.Hash }, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		sim.Commit()
+	}
+	// DeployMarket lets sellers cancel 5 listings a day.
+	const limit, window = 5, 86400
+	for id := int64(1); id <= limit+1; id++ {
+		mine(m.CPNFT.Mint(tokenOwner, seller, levelC))
+		mine(market.CreateListing(owner, seller, big.NewInt(id), uint8(cpop.ListingFixedPrice), big.NewInt(100), big.NewInt(0), big.NewInt(0)))
+	}
+	clock, err := cpoptest.NewTestClock(ctx, sim.Client(), owner, &cpoptest.TestClockOptions{Simulated: sim}, market)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err := cpop.NewDelistingManager(m.Addresses.Marketplace, sim.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := &cpop.MarketplaceRaw{Contract: market}
+
+	// check compares a quota with getDelistingRecord, which reports an
+	// expired window as starting now.
+	check := func(q *cpop.DelistingQuota) {
+		t.Helper()
+		record, err := market.GetDelistingRecord(nil, seller)
+		if err != nil {
+			t.Fatal(err)
+		}
+		start := q.WindowStart
+		if start == 0 {
+			start = clock.Now()
+		}
+		if q.Limit != limit || q.Window != window || q.CheckedAt != clock.Now() ||
+			record.Count.Uint64() != q.Used || record.LastResetTime.Uint64() != start || record.RemainingCount.Uint64() != q.Remaining {
+			t.Fatalf("quota %+v, contract record %+v at %d", q, record, clock.Now())
+		}
+	}
+	// cancel cancels listing id through the manager and checks that the
+	// quota read back is the one Consume predicted.
+	cancel := func(id int64) *cpop.DelistingQuota {
+		t.Helper()
+		before, err := manager.Quota(nil, seller)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := before.Consume(clock.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		mine(manager.CancelListing(owner, big.NewInt(id)))
+		if tracked := manager.Tracked(clock.Now()); len(tracked) != 1 || *tracked[0] != *want {
+			t.Fatalf("tracked %+v after cancelling %d, want %+v", tracked, id, want)
+		}
+		got, err := manager.Quota(nil, seller)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != *want {
+			t.Fatalf("quota %+v after cancelling %d, want %+v", got, id, want)
+		}
+		check(got)
+		return got
+	}
+	// refused checks that both the manager and the contract turn down a
+	// cancellation of listing id.
+	refused := func(id int64) {
+		t.Helper()
+		if _, err := manager.CancelListing(owner, big.NewInt(id)); !errors.Is(err, cpop.ErrDelistingLimitExceeded) {
+			t.Fatalf("cancelling %d: %v, want ErrDelistingLimitExceeded", id, err)
+		}
+		var out []interface{}
+		err := raw.Call(&bind.CallOpts{From: owner.From}, &out, "cancelListing", big.NewInt(id))
+		if revert, ok := cpop.DecodeRevert(err); !ok || revert.Reason != "Delisting limit exceeded" {
+			t.Fatalf("contract cancelling %d: %v", id, err)
+		}
+	}
+
+	first := cancel(1)
+	if first.WindowStart != clock.Now() || first.ResetsAt != clock.Now()+window {
+		t.Fatalf("first cancellation opened %+v at %d", first, clock.Now())
+	}
+	if err := clock.AdvanceMinutes(ctx, 60); err != nil {
+		t.Fatal(err)
+	}
+	for id := int64(2); id <= limit; id++ {
+		if q := cancel(id); q.WindowStart != first.WindowStart {
+			t.Fatalf("cancellation %d moved the window to %d", id, q.WindowStart)
+		}
+	}
+	refused(6)
+
+	// One second before the reset the window is still full; at ResetsAt
+	// the count starts over.
+	if err := clock.Set(ctx, first.ResetsAt-1); err != nil {
+		t.Fatal(err)
+	}
+	refused(6)
+	if err := clock.Set(ctx, first.ResetsAt); err != nil {
+		t.Fatal(err)
+	}
+	q, err := manager.Quota(nil, seller)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Used != 0 || q.Remaining != limit || q.WindowStart != 0 {
+		t.Fatalf("quota %+v after the window", q)
+	}
+	check(q)
+	if q := cancel(6); q.WindowStart != first.ResetsAt {
+		t.Fatalf("new window %+v", q)
+	}
+}
@@ -0,0 +1,384 @@
+package cpop
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// deployContract creates the named contract from its embedded bytecode.
+func deployContract(name string, auth *bind.TransactOpts, backend bind.ContractBackend, params ...interface{}) (common.Address, *types.Transaction, error) {
+	meta, code, err := contractBytecode(name)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	address, tx, _, err := bind.DeployContract(auth, *parsed, code, backend, params...)
+	return address, tx, err
+}
+
+// deployProxy creates an ERC1967Proxy in front of implementation and runs the
+// initialize function of the named contract through it in the same transaction.
+//
+// The implementation must already be mined: the proxy constructor reverts when
+// the implementation has no code, which also breaks gas estimation.
+func deployProxy(name string, auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, params ...interface{}) (common.Address, *types.Transaction, error) {
+	parsed, err := contractMetaData[name].GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	data, err := parsed.Pack("initialize", params...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return deployContract("ERC1967Proxy", auth, backend, implementation, data)
+}
+
+// DeployERC1967Proxy deploys a new ERC1967Proxy contract, binding an instance of ERC1967Proxy to it.
+func DeployERC1967Proxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, data []byte) (common.Address, *types.Transaction, *ERC1967Proxy, error) {
+	address, tx, err := deployContract("ERC1967Proxy", auth, backend, implementation, data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewERC1967Proxy(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployEntryPoint deploys a new EntryPoint contract, binding an instance of EntryPoint to it.
+func DeployEntryPoint(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EntryPoint, error) {
+	address, tx, err := deployContract("EntryPoint", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewEntryPoint(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployCPOPToken deploys a new CPOPToken contract, binding an instance of CPOPToken to it.
+func DeployCPOPToken(auth *bind.TransactOpts, backend bind.ContractBackend, admin common.Address, initialSupply *big.Int) (common.Address, *types.Transaction, *CPOPToken, error) {
+	address, tx, err := deployContract("CPOPToken", auth, backend, admin, initialSupply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewCPOPToken(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployGasPaymaster deploys a new GasPaymaster contract, binding an instance of GasPaymaster to it.
+func DeployGasPaymaster(auth *bind.TransactOpts, backend bind.ContractBackend, entryPoint common.Address, token common.Address, oracle common.Address, fallbackExchangeRate *big.Int, burnTokens bool, beneficiary common.Address) (common.Address, *types.Transaction, *GasPaymaster, error) {
+	address, tx, err := deployContract("GasPaymaster", auth, backend, entryPoint, token, oracle, fallbackExchangeRate, burnTokens, beneficiary)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewGasPaymaster(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployCPNFT deploys a new CPNFT implementation, binding an instance of CPNFT to it.
+func DeployCPNFT(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *CPNFT, error) {
+	address, tx, err := deployContract("CPNFT", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewCPNFT(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployCPNFTProxy deploys an initialized UUPS proxy for a CPNFT implementation.
+// The deployer becomes the owner and token IDs start at 1.
+func DeployCPNFTProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, name string, symbol string, baseTokenURI string) (common.Address, *types.Transaction, *CPNFT, error) {
+	address, tx, err := deployProxy("CPNFT", auth, backend, implementation, name, symbol, baseTokenURI)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewCPNFT(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployMockUSDT deploys a new MockUSDT contract, binding an instance of MockUSDT to it.
+func DeployMockUSDT(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *MockUSDT, error) {
+	address, tx, err := deployContract("MockUSDT", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewMockUSDT(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployPayment deploys a new Payment contract, binding an instance of Payment to it.
+func DeployPayment(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Payment, error) {
+	address, tx, err := deployContract("Payment", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewPayment(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployAAccount deploys a new AAccount implementation, binding an instance of AAccount to it.
+// Accounts themselves are created as proxies by AccountManager.
+func DeployAAccount(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *AAccount, error) {
+	address, tx, err := deployContract("AAccount", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewAAccount(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployAccountManager deploys a new AccountManager implementation, binding an instance of AccountManager to it.
+func DeployAccountManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *AccountManager, error) {
+	address, tx, err := deployContract("AccountManager", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewAccountManager(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployAccountManagerProxy deploys an initialized UUPS proxy for an AccountManager implementation.
+func DeployAccountManagerProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, entryPoint common.Address, owner common.Address) (common.Address, *types.Transaction, *AccountManager, error) {
+	address, tx, err := deployProxy("AccountManager", auth, backend, implementation, entryPoint, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewAccountManager(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployGasPriceOracle deploys a new GasPriceOracle implementation, binding an instance of GasPriceOracle to it.
+func DeployGasPriceOracle(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *GasPriceOracle, error) {
+	address, tx, err := deployContract("GasPriceOracle", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewGasPriceOracle(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployGasPriceOracleProxy deploys an initialized UUPS proxy for a GasPriceOracle implementation.
+func DeployGasPriceOracleProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, owner common.Address, maxPriceAge *big.Int, priceDeviationThreshold *big.Int) (common.Address, *types.Transaction, *GasPriceOracle, error) {
+	address, tx, err := deployProxy("GasPriceOracle", auth, backend, implementation, owner, maxPriceAge, priceDeviationThreshold)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewGasPriceOracle(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployMasterAggregator deploys a new MasterAggregator implementation, binding an instance of MasterAggregator to it.
+func DeployMasterAggregator(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *MasterAggregator, error) {
+	address, tx, err := deployContract("MasterAggregator", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewMasterAggregator(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployMasterAggregatorProxy deploys an initialized UUPS proxy for a MasterAggregator implementation.
+func DeployMasterAggregatorProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, owner common.Address, initialMasters []common.Address) (common.Address, *types.Transaction, *MasterAggregator, error) {
+	address, tx, err := deployProxy("MasterAggregator", auth, backend, implementation, owner, initialMasters)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewMasterAggregator(address, backend)
+	return address, tx, contract, err
+}
+
+// DeploySessionKeyManager deploys a new SessionKeyManager implementation, binding an instance of SessionKeyManager to it.
+func DeploySessionKeyManager(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SessionKeyManager, error) {
+	address, tx, err := deployContract("SessionKeyManager", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewSessionKeyManager(address, backend)
+	return address, tx, contract, err
+}
+
+// DeploySessionKeyManagerProxy deploys an initialized UUPS proxy for a SessionKeyManager implementation.
+func DeploySessionKeyManagerProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, owner common.Address) (common.Address, *types.Transaction, *SessionKeyManager, error) {
+	address, tx, err := deployProxy("SessionKeyManager", auth, backend, implementation, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewSessionKeyManager(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployStaking deploys a new Staking implementation, binding an instance of Staking to it.
+func DeployStaking(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Staking, error) {
+	address, tx, err := deployContract("Staking", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewStaking(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployStakingProxy deploys an initialized UUPS proxy for a Staking implementation.
+func DeployStakingProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, cpnftContract common.Address, cppTokenContract common.Address, accountManagerContract common.Address, configContract common.Address, owner common.Address) (common.Address, *types.Transaction, *Staking, error) {
+	address, tx, err := deployProxy("Staking", auth, backend, implementation, cpnftContract, cppTokenContract, accountManagerContract, configContract, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewStaking(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployStakingReader deploys a new StakingReader implementation, binding an instance of StakingReader to it.
+func DeployStakingReader(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StakingReader, error) {
+	address, tx, err := deployContract("StakingReader", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewStakingReader(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployStakingReaderProxy deploys an initialized UUPS proxy for a StakingReader implementation.
+func DeployStakingReaderProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, stakingContract common.Address, configContract common.Address, cpnftContract common.Address, owner common.Address) (common.Address, *types.Transaction, *StakingReader, error) {
+	address, tx, err := deployProxy("StakingReader", auth, backend, implementation, stakingContract, configContract, cpnftContract, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewStakingReader(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployStakingConfig deploys a new StakingConfig contract, binding an instance of StakingConfig to it.
+// The deployer becomes the owner and the default staking parameters are set.
+func DeployStakingConfig(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StakingConfig, error) {
+	address, tx, err := deployContract("StakingConfig", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewStakingConfig(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployMarketplace deploys a new Marketplace implementation, binding an instance of Marketplace to it.
+func DeployMarketplace(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Marketplace, error) {
+	address, tx, err := deployContract("Marketplace", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewMarketplace(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployMarketplaceProxy deploys an initialized UUPS proxy for a Marketplace implementation.
+func DeployMarketplaceProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, nftContract common.Address, paymentToken common.Address, platformFeeRecipient common.Address, platformFeeRate *big.Int, delistingLimit *big.Int, delistingWindow *big.Int, owner common.Address) (common.Address, *types.Transaction, *Marketplace, error) {
+	address, tx, err := deployProxy("Marketplace", auth, backend, implementation, nftContract, paymentToken, platformFeeRecipient, platformFeeRate, delistingLimit, delistingWindow, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewMarketplace(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployChapoolEarnVault deploys a new ChapoolEarnVault implementation, binding an instance of ChapoolEarnVault to it.
+func DeployChapoolEarnVault(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ChapoolEarnVault, error) {
+	address, tx, err := deployContract("ChapoolEarnVault", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewChapoolEarnVault(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployChapoolEarnVaultProxy deploys an initialized UUPS proxy for a ChapoolEarnVault implementation.
+func DeployChapoolEarnVaultProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, asset common.Address, cppToken common.Address, accountManager common.Address, owner common.Address) (common.Address, *types.Transaction, *ChapoolEarnVault, error) {
+	address, tx, err := deployProxy("ChapoolEarnVault", auth, backend, implementation, asset, cppToken, accountManager, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewChapoolEarnVault(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployVeCPOTLocker deploys a new VeCPOTLocker implementation, binding an instance of VeCPOTLocker to it.
+func DeployVeCPOTLocker(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *VeCPOTLocker, error) {
+	address, tx, err := deployContract("VeCPOTLocker", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewVeCPOTLocker(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployVeCPOTLockerProxy deploys an initialized UUPS proxy for a VeCPOTLocker implementation.
+func DeployVeCPOTLockerProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, cpot common.Address, owner common.Address) (common.Address, *types.Transaction, *VeCPOTLocker, error) {
+	address, tx, err := deployProxy("VeCPOTLocker", auth, backend, implementation, cpot, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewVeCPOTLocker(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployNFTBoostController deploys a new NFTBoostController implementation, binding an instance of NFTBoostController to it.
+func DeployNFTBoostController(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *NFTBoostController, error) {
+	address, tx, err := deployContract("NFTBoostController", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewNFTBoostController(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployNFTBoostControllerProxy deploys an initialized UUPS proxy for an NFTBoostController implementation.
+func DeployNFTBoostControllerProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, cpnft common.Address, owner common.Address) (common.Address, *types.Transaction, *NFTBoostController, error) {
+	address, tx, err := deployProxy("NFTBoostController", auth, backend, implementation, cpnft, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewNFTBoostController(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployChapoolRewardDistributor deploys a new ChapoolRewardDistributor implementation, binding an instance of ChapoolRewardDistributor to it.
+func DeployChapoolRewardDistributor(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ChapoolRewardDistributor, error) {
+	address, tx, err := deployContract("ChapoolRewardDistributor", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewChapoolRewardDistributor(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployChapoolRewardDistributorProxy deploys an initialized UUPS proxy for a ChapoolRewardDistributor implementation.
+func DeployChapoolRewardDistributorProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation common.Address, owner common.Address) (common.Address, *types.Transaction, *ChapoolRewardDistributor, error) {
+	address, tx, err := deployProxy("ChapoolRewardDistributor", auth, backend, implementation, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewChapoolRewardDistributor(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployChapoolVaultReader deploys a new ChapoolVaultReader contract, binding an instance of ChapoolVaultReader to it.
+// Zero addresses are allowed for the EARN contracts that are not deployed yet.
+func DeployChapoolVaultReader(auth *bind.TransactOpts, backend bind.ContractBackend, earnVault common.Address, vecpotLocker common.Address, nftBoostController common.Address, rewardDistributor common.Address, owner common.Address) (common.Address, *types.Transaction, *ChapoolVaultReader, error) {
+	address, tx, err := deployContract("ChapoolVaultReader", auth, backend, earnVault, vecpotLocker, nftBoostController, rewardDistributor, owner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewChapoolVaultReader(address, backend)
+	return address, tx, contract, err
+}
+
+// DeployBatchTransfer deploys a new BatchTransfer contract, binding an instance of BatchTransfer to it.
+func DeployBatchTransfer(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *BatchTransfer, error) {
+	address, tx, err := deployContract("BatchTransfer", auth, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	contract, err := NewBatchTransfer(address, backend)
+	return address, tx, contract, err
+}
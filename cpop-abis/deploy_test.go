@@ -0,0 +1,117 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// deployer mines the Deploy functions' transactions one at a time.
+type deployer struct {
+	t   *testing.T
+	sim *simulated.Backend
+}
+
+// mined takes the results of a DeployX function, mines its transaction and
+// checks that code was created at the address.
+func (d *deployer) mined(address common.Address, tx *types.Transaction, _ interface{}, err error) common.Address {
+	d.t.Helper()
+	if err != nil {
+		d.t.Fatalf("deploy: %v", err)
+	}
+	if err := cpoptest.Mine(d.sim, tx); err != nil {
+		d.t.Fatalf("deploy: %v", err)
+	}
+	code, err := d.sim.Client().CodeAt(context.Background(), address, nil)
+	if err != nil {
+		d.t.Fatal(err)
+	}
+	if len(code) == 0 {
+		d.t.Fatalf("no code at %s", address.Hex())
+	}
+	return address
+}
+
+func TestDeployAll(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	client := sim.Client()
+	owner := auth.From
+	d := &deployer{t: t, sim: sim}
+
+	for _, name := range []string{
+		"AAccount", "AccountManager", "BatchTransfer", "CPNFT", "CPOPToken", "ChapoolEarnVault",
+		"ChapoolRewardDistributor", "ChapoolVaultReader", "ERC1967Proxy", "EntryPoint", "GasPaymaster",
+		"GasPriceOracle", "Marketplace", "MasterAggregator", "MockUSDT", "NFTBoostController", "Payment",
+		"SessionKeyManager", "Staking", "StakingConfig", "StakingReader", "VeCPOTLocker",
+	} {
+		if !cpop.HasBytecode(name) {
+			t.Errorf("%s bytecode not embedded", name)
+		}
+	}
+
+	entryPoint := d.mined(cpop.DeployEntryPoint(auth, client))
+	token := d.mined(cpop.DeployCPOPToken(auth, client, owner, big.NewInt(1e18)))
+	usdt := d.mined(cpop.DeployMockUSDT(auth, client))
+	d.mined(cpop.DeployPayment(auth, client))
+	d.mined(cpop.DeployAAccount(auth, client))
+	d.mined(cpop.DeployBatchTransfer(auth, client))
+	config := d.mined(cpop.DeployStakingConfig(auth, client))
+
+	impl := d.mined(cpop.DeployGasPriceOracle(auth, client))
+	oracle := d.mined(cpop.DeployGasPriceOracleProxy(auth, client, impl, owner, big.NewInt(3600), big.NewInt(500)))
+	d.mined(cpop.DeployGasPaymaster(auth, client, entryPoint, token, oracle, big.NewInt(1e18), false, owner))
+	impl = d.mined(cpop.DeployMasterAggregator(auth, client))
+	d.mined(cpop.DeployMasterAggregatorProxy(auth, client, impl, owner, []common.Address{owner}))
+	impl = d.mined(cpop.DeploySessionKeyManager(auth, client))
+	d.mined(cpop.DeploySessionKeyManagerProxy(auth, client, impl, owner))
+	impl = d.mined(cpop.DeployAccountManager(auth, client))
+	manager := d.mined(cpop.DeployAccountManagerProxy(auth, client, impl, entryPoint, owner))
+
+	impl = d.mined(cpop.DeployCPNFT(auth, client))
+	nftAddr, tx, nft, err := cpop.DeployCPNFTProxy(auth, client, impl, "CPNFT", "CPNFT", "https://example.com/")
+	d.mined(nftAddr, tx, nft, err)
+	impl = d.mined(cpop.DeployStaking(auth, client))
+	staking := d.mined(cpop.DeployStakingProxy(auth, client, impl, nftAddr, token, manager, config, owner))
+	impl = d.mined(cpop.DeployStakingReader(auth, client))
+	d.mined(cpop.DeployStakingReaderProxy(auth, client, impl, staking, config, nftAddr, owner))
+	impl = d.mined(cpop.DeployMarketplace(auth, client))
+	d.mined(cpop.DeployMarketplaceProxy(auth, client, impl, nftAddr, usdt, owner, big.NewInt(250), big.NewInt(5), big.NewInt(86400), owner))
+
+	impl = d.mined(cpop.DeployChapoolEarnVault(auth, client))
+	vault := d.mined(cpop.DeployChapoolEarnVaultProxy(auth, client, impl, usdt, token, manager, owner))
+	impl = d.mined(cpop.DeployVeCPOTLocker(auth, client))
+	locker := d.mined(cpop.DeployVeCPOTLockerProxy(auth, client, impl, token, owner))
+	impl = d.mined(cpop.DeployNFTBoostController(auth, client))
+	boost := d.mined(cpop.DeployNFTBoostControllerProxy(auth, client, impl, nftAddr, owner))
+	impl = d.mined(cpop.DeployChapoolRewardDistributor(auth, client))
+	distributor := d.mined(cpop.DeployChapoolRewardDistributorProxy(auth, client, impl, owner))
+	d.mined(cpop.DeployChapoolVaultReader(auth, client, vault, locker, boost, distributor, owner))
+
+	// CPNFT runs initialize through the proxy: the deployer owns it and the
+	// first token is 1.
+	if got, err := nft.Owner(nil); err != nil || got != owner {
+		t.Fatalf("CPNFT owner = %s, %v; want %s", got.Hex(), err, owner.Hex())
+	}
+	tx, err = nft.Mint(auth, owner, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cpoptest.Mine(sim, tx); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := nft.OwnerOf(&bind.CallOpts{}, big.NewInt(1)); err != nil || got != owner {
+		t.Fatalf("owner of token 1 = %s, %v; want %s", got.Hex(), err, owner.Hex())
+	}
+}
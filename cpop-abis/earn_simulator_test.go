@@ -0,0 +1,229 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// TestEarnSimulatorContract replays deposits, withdrawals, claims, locks and
+// NFT boosts against ChapoolEarnVault, VeCPOTLocker and NFTBoostController
+// and compares the simulator with the state loaded after every step.
+func TestEarnSimulatorContract(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	aliceKey, _ := crypto.GenerateKey()
+	bobKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key, aliceKey, bobKey)
+	defer sim.Close()
+	client := sim.Client()
+	auth := cpoptest.NewTransactor(key)
+	aliceAuth, bobAuth := cpoptest.NewTransactor(aliceKey), cpoptest.NewTransactor(bobKey)
+	alice, bob := aliceAuth.From, bobAuth.From
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := cpoptest.DeployEarn(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Gas is estimated at the head block, whose timestamp the accumulator
+	// was just brought up to; mined a second later, the call also updates
+	// the accumulator and runs short.
+	accruing := func(auth *bind.TransactOpts) *bind.TransactOpts {
+		opts := *auth
+		opts.GasLimit = 1_000_000
+		return &opts
+	}
+	units := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18)) }
+
+	// Bob holds a B token, alice a C token she never activates.
+	for _, u := range []*bind.TransactOpts{aliceAuth, bobAuth} {
+		mined(e.USDT.Mint(auth, u.From, units(10_000)))
+		mined(e.CPOT.Mint(auth, u.From, units(10_000)))
+		mined(e.USDT.Approve(u, e.Addresses.ChapoolEarnVault, units(10_000)))
+		mined(e.CPOT.Approve(u, e.Addresses.VeCPOTLocker, units(10_000)))
+	}
+	mined(e.CPNFT.Mint(auth, alice, levelC))
+	mined(e.CPNFT.Mint(auth, bob, levelB))
+	bobToken := big.NewInt(2)
+
+	s, err := cpop.LoadEarnSimulator(&bind.CallOpts{Context: ctx}, client, e.Addresses.ChapoolEarnVault, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// check moves the simulator to the latest block, applies the step and
+	// compares it with the contracts.
+	check := func(name string, apply func() error) {
+		t.Helper()
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Warp(header.Time); err != nil {
+			t.Fatal(err)
+		}
+		if apply != nil {
+			if err := apply(); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+		opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+		loaded, err := cpop.LoadEarnSimulator(opts, client, e.Addresses.ChapoolEarnVault, alice, bob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compareEarn(t, name, s, loaded)
+		if err := s.Verify(opts, client); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		for _, user := range []common.Address{alice, bob} {
+			boost, err := e.ChapoolEarnVault.GetUserBoostBps(opts, user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if boost.Uint64() != s.BoostBps(user) {
+				t.Fatalf("%s: boost of %s %d, vault %s", name, user.Hex(), s.BoostBps(user), boost)
+			}
+		}
+	}
+	advance := func(d time.Duration) {
+		t.Helper()
+		if err := sim.AdjustTime(d); err != nil {
+			t.Fatal(err)
+		}
+		check("advance "+d.String(), nil)
+	}
+	claim := func(auth *bind.TransactOpts) {
+		t.Helper()
+		account, err := core.AccountManager.GetAccountAddress(nil, auth.From, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		before, err := core.CPOPToken.BalanceOf(nil, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mined(e.ChapoolEarnVault.ClaimCPP(accruing(auth)))
+		after, err := core.CPOPToken.BalanceOf(nil, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check("claim", func() error {
+			amount, err := s.Claim(auth.From)
+			if err == nil && new(big.Int).Sub(after, before).Cmp(amount) != 0 {
+				t.Errorf("claimed %s, account received %s", amount, new(big.Int).Sub(after, before))
+			}
+			return err
+		})
+	}
+	rate := big.NewInt(1e15)
+
+	mined(e.ChapoolEarnVault.SetRewardRate(auth, rate))
+	check("set reward rate", func() error { s.SetRewardRate(rate); return nil })
+	mined(e.ChapoolEarnVault.Deposit(accruing(aliceAuth), units(1_000), alice))
+	check("alice deposit", func() error { return s.Deposit(alice, units(1_000)) })
+	advance(time.Hour)
+	mined(e.ChapoolEarnVault.Deposit(accruing(bobAuth), units(500), bob))
+	check("bob deposit", func() error { return s.Deposit(bob, units(500)) })
+	mined(e.VeCPOTLocker.Lock(accruing(aliceAuth), units(1_000), big.NewInt(180)))
+	check("alice lock", func() error { _, err := s.Lock(alice, units(1_000), 180); return err })
+	advance(24 * time.Hour)
+
+	// The controller does not notify the vault: the boost shows up in
+	// getUserBoostBps but not in the weight until syncBoost.
+	mined(e.NFTBoostController.ActivateBoost(bobAuth, bobToken))
+	check("bob activate", func() error { s.ActivateNFT(bob, levelB); return nil })
+	if a := s.Accounts[bob]; a.WeightedUSDT.Cmp(units(500)) != 0 {
+		t.Fatalf("bob weighted %s before syncBoost", a.WeightedUSDT)
+	}
+	mined(e.ChapoolEarnVault.SyncBoost(accruing(auth), bob))
+	check("bob sync", func() error { s.SyncBoost(bob); return nil })
+	advance(36 * time.Hour)
+
+	mined(e.VeCPOTLocker.LockMore(accruing(aliceAuth), big.NewInt(0), units(600)))
+	check("alice lock more", func() error { return s.LockMore(alice, 0, units(600)) })
+	mined(e.VeCPOTLocker.Lock(accruing(bobAuth), units(3_000), big.NewInt(30)))
+	check("bob lock", func() error { _, err := s.Lock(bob, units(3_000), 30); return err })
+	mined(e.VeCPOTLocker.Lock(accruing(aliceAuth), units(200), big.NewInt(90)))
+	check("alice second lock", func() error { _, err := s.Lock(alice, units(200), 90); return err })
+	advance(10 * 24 * time.Hour)
+
+	mined(e.ChapoolEarnVault.Withdraw(accruing(aliceAuth), units(400), alice))
+	check("alice withdraw", func() error { return s.Withdraw(alice, units(400)) })
+	claim(aliceAuth)
+
+	// Bob's lock expires: the locker stops counting it at once, the vault
+	// weight only on the next sync.
+	advance(21 * 24 * time.Hour)
+	if s.VeBoostBps(bob) != 0 || s.Accounts[bob].WeightedUSDT.Cmp(units(500)) <= 0 {
+		t.Fatalf("bob ve boost %d, weighted %s after expiry", s.VeBoostBps(bob), s.Accounts[bob].WeightedUSDT)
+	}
+	mined(e.VeCPOTLocker.Unlock(accruing(bobAuth), big.NewInt(0)))
+	check("bob unlock", func() error { return s.Unlock(bob, 0) })
+	mined(e.NFTBoostController.DeactivateBoost(bobAuth))
+	check("bob deactivate", func() error { s.DeactivateNFT(bob); return nil })
+	mined(e.ChapoolEarnVault.SyncBoost(accruing(auth), bob))
+	check("bob sync", func() error { s.SyncBoost(bob); return nil })
+	advance(5 * 24 * time.Hour)
+	claim(bobAuth)
+	claim(aliceAuth)
+	mined(e.ChapoolEarnVault.Withdraw(accruing(bobAuth), units(500), bob))
+	check("bob withdraw", func() error { return s.Withdraw(bob, units(500)) })
+}
+
+// compareEarn fails the test if the simulator state differs from the state
+// loaded from the contracts.
+func compareEarn(t *testing.T, step string, got, want *cpop.EarnSimulator) {
+	t.Helper()
+	eq := func(field string, got, want *big.Int) {
+		t.Helper()
+		if got.Cmp(want) != 0 {
+			t.Fatalf("%s: %s %s, contracts %s", step, field, got, want)
+		}
+	}
+	if got.Now != want.Now || got.LastUpdateTime != want.LastUpdateTime {
+		t.Fatalf("%s: time %d updated %d, contracts %d updated %d", step, got.Now, got.LastUpdateTime, want.Now, want.LastUpdateTime)
+	}
+	eq("reward rate", got.RewardRate, want.RewardRate)
+	eq("accumulator", got.AccCPPPerWeightedUSDT, want.AccCPPPerWeightedUSDT)
+	eq("total weighted USDT", got.TotalWeightedUSDT, want.TotalWeightedUSDT)
+	for user, w := range want.Accounts {
+		g := got.Account(user)
+		eq("balance of "+user.Hex(), g.USDTBalance, w.USDTBalance)
+		eq("weighted USDT of "+user.Hex(), g.WeightedUSDT, w.WeightedUSDT)
+		eq("reward debt of "+user.Hex(), g.RewardDebt, w.RewardDebt)
+		eq("pending CPP of "+user.Hex(), g.PendingCPP, w.PendingCPP)
+		if g.NextLockID != w.NextLockID || len(g.Locks) != len(w.Locks) || g.HasNFT != w.HasNFT || g.NFTLevel != w.NFTLevel {
+			t.Fatalf("%s: %s has %d locks, next %d, NFT %v level %d; contracts %d locks, next %d, NFT %v level %d",
+				step, user.Hex(), len(g.Locks), g.NextLockID, g.HasNFT, g.NFTLevel, len(w.Locks), w.NextLockID, w.HasNFT, w.NFTLevel)
+		}
+		for i, l := range w.Locks {
+			gl := g.Locks[i]
+			if gl.LockID != l.LockID || gl.StartTime != l.StartTime || gl.UnlockTime != l.UnlockTime || gl.DurationDays != l.DurationDays {
+				t.Fatalf("%s: lock %+v, contracts %+v", step, gl, l)
+			}
+			eq("lock amount", gl.Amount, l.Amount)
+			eq("lock veCPOT", gl.VeAmount, l.VeAmount)
+		}
+	}
+}
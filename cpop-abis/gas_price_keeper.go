@@ -0,0 +1,515 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultPriceKeeperPollInterval is the interval PriceKeeper.Run checks the
+// feeds at when none is configured.
+const DefaultPriceKeeperPollInterval = time.Minute
+
+// Price feeds created by GasPriceOracle.initialize.
+const (
+	PriceFeedETHUSD  = "ETH/USD"
+	PriceFeedCPOPUSD = "CPOP/USD"
+)
+
+// ErrOracleUnauthorized is returned when the keeper account may not call
+// GasPriceOracle.updatePriceFeed.
+var ErrOracleUnauthorized = errors.New("account is not an authorized oracle")
+
+// PriceSource is an off-chain price source of GasPriceOracle feeds. Prices
+// are in the unit of the on-chain feed.
+type PriceSource interface {
+	Name() string
+	Price(ctx context.Context, feed string) (*big.Int, error)
+}
+
+// MedianPrice returns the median of prices, averaging the two middle prices
+// of an even count. It returns nil for no prices.
+func MedianPrice(prices []*big.Int) *big.Int {
+	if len(prices) == 0 {
+		return nil
+	}
+	sorted := append([]*big.Int(nil), prices...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
+	}
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return sum.Rsh(sum, 1)
+}
+
+// PriceDeviation returns the deviation of newPrice from oldPrice in basis
+// points the way GasPriceOracle._calculatePriceDeviation does.
+func PriceDeviation(oldPrice, newPrice *big.Int) uint64 {
+	if oldPrice.Sign() == 0 {
+		return 0
+	}
+	diff := new(big.Int).Sub(newPrice, oldPrice)
+	diff.Abs(diff).Mul(diff, big.NewInt(basisPoints))
+	return diff.Quo(diff, oldPrice).Uint64()
+}
+
+// PriceAlertKind classifies a PriceAlert.
+type PriceAlertKind uint8
+
+const (
+	// PriceAlertInvalid means IsPriceValid is false: the feed price is
+	// stale, zero or the feed is inactive, so readers get the fallback price
+	// or a revert when there is none.
+	PriceAlertInvalid PriceAlertKind = iota
+	// PriceAlertFallbackUsed means readers get the fallback price: either
+	// GetPriceWithMetadata returns it, or a FallbackPriceUsed event was
+	// emitted.
+	PriceAlertFallbackUsed
+	// PriceAlertDeviation means the median moved more than the deviation
+	// threshold from a fresh on-chain price, so the update would revert.
+	PriceAlertDeviation
+	// PriceAlertSources means fewer than MinSources sources returned a price.
+	PriceAlertSources
+	// PriceAlertInactive means the feed does not exist on the oracle, so
+	// updates would revert. It has to be added with AddPriceFeed.
+	PriceAlertInactive
+)
+
+func (k PriceAlertKind) String() string {
+	switch k {
+	case PriceAlertInvalid:
+		return "invalid"
+	case PriceAlertFallbackUsed:
+		return "fallback-used"
+	case PriceAlertDeviation:
+		return "deviation"
+	case PriceAlertSources:
+		return "sources"
+	case PriceAlertInactive:
+		return "inactive"
+	default:
+		return fmt.Sprintf("PriceAlertKind(%d)", int(k))
+	}
+}
+
+// PriceAlert is a condition of a feed that needs attention.
+type PriceAlert struct {
+	Feed    string
+	Kind    PriceAlertKind
+	Message string
+	Event   *GasPriceOracleFallbackPriceUsed // set when the alert comes from the event
+}
+
+// PriceUpdate is an UpdatePriceFeed transaction sent by PriceKeeper.
+type PriceUpdate struct {
+	Feed      string
+	OldPrice  *big.Int // fresh on-chain price before the update, nil if there was none
+	NewPrice  *big.Int // median of the sources
+	Deviation uint64   // basis points, zero when the old price was not fresh
+	Refresh   bool     // sent because the price was about to go stale rather than because it moved
+	Tx        *types.Transaction
+}
+
+// PriceKeeperBackend is the node access needed by PriceKeeper. It is
+// implemented by *ethclient.Client and the simulated backend client.
+type PriceKeeperBackend interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// PriceKeeperConfig configures a PriceKeeper.
+type PriceKeeperConfig struct {
+	Feeds      []string // feed types, PriceFeedETHUSD and PriceFeedCPOPUSD by default
+	MinSources int      // sources that must return a price, 1 by default
+	// MinChange is the deviation in basis points from the on-chain price
+	// below which the price is not pushed unless it is about to go stale.
+	MinChange uint64
+	// RefreshBefore is how many seconds before GetMaxPriceAge an unchanged
+	// price is pushed again, a tenth of the maximum age by default.
+	RefreshBefore uint64
+	FromBlock     uint64        // first block scanned for FallbackPriceUsed, the latest block by default
+	PollInterval  time.Duration // interval of Run
+}
+
+// PriceKeeper pushes GasPriceOracle feed prices. Each tick it takes the
+// median of its sources and sends UpdatePriceFeed when the median moved by
+// MinChange or the on-chain price is close to GetMaxPriceAge. A median beyond
+// GetPriceDeviationThreshold of a fresh on-chain price is not sent, as the
+// oracle would reject it, and raises a PriceAlertDeviation instead.
+//
+// At most one update per feed is in flight: the feed is skipped until its
+// receipt is found or its nonce was used by another transaction.
+type PriceKeeper struct {
+	address common.Address
+	oracle  *GasPriceOracle
+	sources []PriceSource
+	backend PriceKeeperBackend
+	auth    *bind.TransactOpts
+	config  PriceKeeperConfig
+
+	mu        sync.Mutex
+	pending   map[string]*PriceUpdate
+	feedByTag map[common.Hash]string
+	nextBlock uint64
+	scanned   bool
+}
+
+// NewPriceKeeper returns a keeper for the GasPriceOracle at address sending
+// updates with auth, which must be an authorized oracle or the owner.
+func NewPriceKeeper(address common.Address, backend PriceKeeperBackend, auth *bind.TransactOpts, sources []PriceSource, config PriceKeeperConfig) (*PriceKeeper, error) {
+	oracle, err := NewGasPriceOracle(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, errors.New("no price sources")
+	}
+	if len(config.Feeds) == 0 {
+		config.Feeds = []string{PriceFeedETHUSD, PriceFeedCPOPUSD}
+	}
+	if config.MinSources <= 0 {
+		config.MinSources = 1
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultPriceKeeperPollInterval
+	}
+	k := &PriceKeeper{
+		address:   address,
+		oracle:    oracle,
+		sources:   sources,
+		backend:   backend,
+		auth:      auth,
+		config:    config,
+		pending:   make(map[string]*PriceUpdate),
+		feedByTag: make(map[common.Hash]string),
+		nextBlock: config.FromBlock,
+		scanned:   config.FromBlock != 0,
+	}
+	for _, feed := range config.Feeds {
+		k.feedByTag[crypto.Keccak256Hash([]byte(feed))] = feed
+	}
+	return k, nil
+}
+
+// Pending returns the updates awaiting confirmation.
+func (k *PriceKeeper) Pending() []*PriceUpdate {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	out := make([]*PriceUpdate, 0, len(k.pending))
+	for _, u := range k.pending {
+		out = append(out, u)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Feed < out[j].Feed })
+	return out
+}
+
+// Run calls Tick every PollInterval until ctx is done or Tick fails. Sent
+// updates are passed to handle and alerts to alert; either may be nil.
+func (k *PriceKeeper) Run(ctx context.Context, handle func(*PriceUpdate), alert func(*PriceAlert)) error {
+	ticker := time.NewTicker(k.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		sent, alerts, err := k.Tick(ctx)
+		if handle != nil {
+			for _, u := range sent {
+				handle(u)
+			}
+		}
+		if alert != nil {
+			for _, a := range alerts {
+				alert(a)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick scans for FallbackPriceUsed events and checks every feed, sending
+// updates where needed. Failures of single feeds do not stop the others;
+// they are joined into the returned error.
+func (k *PriceKeeper) Tick(ctx context.Context) ([]*PriceUpdate, []*PriceAlert, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	opts := &bind.CallOpts{Context: ctx}
+	authorized, err := k.oracle.AuthorizedOracles(opts, k.auth.From)
+	if err != nil {
+		return nil, nil, fmt.Errorf("authorized oracles: %w", err)
+	}
+	if !authorized {
+		owner, err := k.oracle.Owner(opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("owner: %w", err)
+		}
+		if owner != k.auth.From {
+			return nil, nil, fmt.Errorf("%w: %s", ErrOracleUnauthorized, k.auth.From.Hex())
+		}
+	}
+	head, err := k.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("latest header: %w", err)
+	}
+	alerts, err := k.scan(ctx, head.Number.Uint64())
+	if err != nil {
+		return nil, nil, err
+	}
+	paused, err := k.oracle.Paused(opts)
+	if err != nil {
+		return nil, alerts, fmt.Errorf("paused: %w", err)
+	}
+	maxAge, err := k.oracle.GetMaxPriceAge(opts)
+	if err != nil {
+		return nil, alerts, fmt.Errorf("max price age: %w", err)
+	}
+	threshold, err := k.oracle.GetPriceDeviationThreshold(opts)
+	if err != nil {
+		return nil, alerts, fmt.Errorf("price deviation threshold: %w", err)
+	}
+
+	var (
+		sent []*PriceUpdate
+		errs []error
+	)
+	for _, feed := range k.config.Feeds {
+		u, feedAlerts, err := k.check(ctx, feed, head.Time, maxAge.Uint64(), threshold.Uint64(), paused)
+		alerts = append(alerts, feedAlerts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("feed %s: %w", feed, err))
+		}
+		if u != nil {
+			sent = append(sent, u)
+		}
+	}
+	return sent, alerts, errors.Join(errs...)
+}
+
+// scan returns an alert for every FallbackPriceUsed event up to head.
+//
+// GasPriceOracle.sol declares the event but never emits it, its price getters
+// being views, so this only reports anything once a contract upgrade emits
+// it. Until then check detects fallback prices with GetPriceWithMetadata.
+func (k *PriceKeeper) scan(ctx context.Context, head uint64) ([]*PriceAlert, error) {
+	if !k.scanned {
+		k.nextBlock, k.scanned = head+1, true
+		return nil, nil
+	}
+	if k.nextBlock > head {
+		return nil, nil
+	}
+	it, err := k.oracle.FilterFallbackPriceUsed(&bind.FilterOpts{Start: k.nextBlock, End: &head, Context: ctx}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fallback price events: %w", err)
+	}
+	defer it.Close()
+	var alerts []*PriceAlert
+	for it.Next() {
+		feed, ok := k.feedByTag[it.Event.FeedType]
+		if !ok {
+			feed = it.Event.FeedType.Hex()
+		}
+		alerts = append(alerts, &PriceAlert{
+			Feed:    feed,
+			Kind:    PriceAlertFallbackUsed,
+			Message: fmt.Sprintf("fallback price %s used in block %d: %s", it.Event.FallbackPrice, it.Event.Raw.BlockNumber, it.Event.Reason),
+			Event:   it.Event,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("fallback price events: %w", err)
+	}
+	k.nextBlock = head + 1
+	return alerts, nil
+}
+
+// check confirms the pending update of a feed or pushes the median of the
+// sources when it is due.
+func (k *PriceKeeper) check(ctx context.Context, feed string, now, maxAge, threshold uint64, paused bool) (*PriceUpdate, []*PriceAlert, error) {
+	var alerts []*PriceAlert
+	opts := &bind.CallOpts{Context: ctx}
+	valid, err := k.oracle.IsPriceValid(opts, feed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("price valid: %w", err)
+	}
+	// While the price is valid GetPriceWithMetadata returns the fresh feed
+	// price, which the oracle checks the deviation against. Otherwise it
+	// returns the fallback price, valid if set, and there is no deviation
+	// check.
+	current, err := k.oracle.GetPriceWithMetadata(opts, feed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("price: %w", err)
+	}
+	if !valid {
+		alerts = append(alerts, &PriceAlert{Feed: feed, Kind: PriceAlertInvalid, Message: "on-chain price is stale, zero or inactive"})
+		if current.IsValid {
+			alerts = append(alerts, &PriceAlert{Feed: feed, Kind: PriceAlertFallbackUsed, Message: fmt.Sprintf("readers get the fallback price %s", current.Price)})
+		}
+	}
+	if u := k.pending[feed]; u != nil {
+		done, err := k.confirm(ctx, u)
+		if err != nil || !done {
+			return nil, alerts, err
+		}
+		delete(k.pending, feed)
+	}
+	if paused {
+		return nil, alerts, nil
+	}
+	if !valid {
+		active, err := k.active(ctx, feed)
+		if err != nil {
+			return nil, alerts, err
+		}
+		if !active {
+			return nil, append(alerts, &PriceAlert{Feed: feed, Kind: PriceAlertInactive, Message: "feed is not active on the oracle"}), nil
+		}
+	}
+
+	median, alert := k.median(ctx, feed)
+	if alert != nil {
+		return nil, append(alerts, alert), nil
+	}
+	u := &PriceUpdate{Feed: feed, NewPrice: median}
+	if valid {
+		u.OldPrice = bigOrZero(current.Price)
+		u.Deviation = PriceDeviation(u.OldPrice, median)
+		if u.Deviation > threshold {
+			return nil, append(alerts, &PriceAlert{
+				Feed:    feed,
+				Kind:    PriceAlertDeviation,
+				Message: fmt.Sprintf("median %s deviates %d bps from %s, above the threshold of %d bps", median, u.Deviation, u.OldPrice, threshold),
+			}), nil
+		}
+		refreshBefore := k.config.RefreshBefore
+		if refreshBefore == 0 {
+			refreshBefore = maxAge / 10
+		}
+		moved := u.Deviation > 0 && u.Deviation >= k.config.MinChange
+		u.Refresh = !moved && now+refreshBefore >= bigOrZero(current.Timestamp).Uint64()+maxAge
+		if !moved && !u.Refresh {
+			return nil, alerts, nil
+		}
+	}
+	auth := *k.auth
+	auth.Context = ctx
+	if auth.GasLimit == 0 {
+		if auth.GasLimit, err = k.estimate(ctx, feed, median); err != nil {
+			return nil, alerts, err
+		}
+	}
+	if u.Tx, err = k.oracle.UpdatePriceFeed(&auth, feed, median); err != nil {
+		return nil, alerts, fmt.Errorf("update price feed: %w", AsRevertError(err))
+	}
+	k.pending[feed] = u
+	return u, alerts, nil
+}
+
+// estimate returns the gas limit of an update with a margin. The estimate
+// runs at the latest block: when the feed was updated in it, storing the
+// timestamp is priced as a no-op.
+func (k *PriceKeeper) estimate(ctx context.Context, feed string, price *big.Int) (uint64, error) {
+	parsed, err := GasPriceOracleMetaData.GetAbi()
+	if err != nil {
+		return 0, err
+	}
+	data, err := parsed.Pack("updatePriceFeed", feed, price)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := k.backend.EstimateGas(ctx, ethereum.CallMsg{From: k.auth.From, To: &k.address, Data: data})
+	if err != nil {
+		return 0, fmt.Errorf("estimate price feed update: %w", AsRevertError(err))
+	}
+	return gas + gas/5, nil
+}
+
+// active reports whether feed is active on the oracle. GasPriceOracle has no
+// getter for it, so an update is run as a call and its revert checked.
+func (k *PriceKeeper) active(ctx context.Context, feed string) (bool, error) {
+	raw := &GasPriceOracleCallerRaw{Contract: &k.oracle.GasPriceOracleCaller}
+	var out []interface{}
+	err := raw.Call(&bind.CallOpts{Context: ctx, From: k.auth.From}, &out, "updatePriceFeed", feed, big.NewInt(1))
+	if err == nil {
+		return true, nil
+	}
+	if revert, ok := DecodeRevert(err); ok && revert.Contract == "GasPriceOracle" && revert.Reason == "feed not active" {
+		return false, nil
+	}
+	return false, fmt.Errorf("check feed active: %w", AsRevertError(err))
+}
+
+// median queries every source and returns the median of the prices, or an
+// alert when fewer than MinSources answered.
+func (k *PriceKeeper) median(ctx context.Context, feed string) (*big.Int, *PriceAlert) {
+	type answer struct {
+		price *big.Int
+		err   error
+	}
+	answers := make([]answer, len(k.sources))
+	var wg sync.WaitGroup
+	for i, source := range k.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			price, err := source.Price(ctx, feed)
+			if err == nil && (price == nil || price.Sign() <= 0) {
+				err = fmt.Errorf("invalid price %v", price)
+			}
+			answers[i] = answer{price, err}
+		}()
+	}
+	wg.Wait()
+
+	var (
+		prices []*big.Int
+		failed []string
+	)
+	for i, a := range answers {
+		if a.err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", k.sources[i].Name(), a.err))
+			continue
+		}
+		prices = append(prices, a.price)
+	}
+	if len(prices) < k.config.MinSources {
+		return nil, &PriceAlert{
+			Feed:    feed,
+			Kind:    PriceAlertSources,
+			Message: fmt.Sprintf("%d of %d sources answered, %d required: %s", len(prices), len(k.sources), k.config.MinSources, strings.Join(failed, "; ")),
+		}
+	}
+	return MedianPrice(prices), nil
+}
+
+// confirm reports whether the update u is final. An update whose nonce was
+// taken by another transaction is dropped so the feed is checked again.
+func (k *PriceKeeper) confirm(ctx context.Context, u *PriceUpdate) (bool, error) {
+	if _, err := k.backend.TransactionReceipt(ctx, u.Tx.Hash()); err == nil {
+		return true, nil // a reverted update is retried on this tick
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return false, fmt.Errorf("receipt of %s: %w", u.Tx.Hash().Hex(), err)
+	}
+	nonce, err := k.backend.NonceAt(ctx, k.auth.From, nil)
+	if err != nil {
+		return false, fmt.Errorf("nonce: %w", err)
+	}
+	return nonce > u.Tx.Nonce(), nil
+}
@@ -0,0 +1,288 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestMedianPrice(t *testing.T) {
+	for _, c := range []struct {
+		prices []int64
+		want   int64
+	}{
+		{[]int64{7}, 7},
+		{[]int64{3, 1, 2}, 2},
+		{[]int64{5, 9, 1, 9, 2}, 5},
+		{[]int64{4, 1, 3, 2}, 2}, // (2+3)/2 rounds down
+		{[]int64{10, 20}, 15},
+		{[]int64{8, 8, 1, 100}, 8},
+	} {
+		prices := make([]*big.Int, len(c.prices))
+		for i, p := range c.prices {
+			prices[i] = big.NewInt(p)
+		}
+		if got := cpop.MedianPrice(prices); got == nil || got.Int64() != c.want {
+			t.Errorf("MedianPrice(%v) = %v, want %d", c.prices, got, c.want)
+		}
+		for i, p := range c.prices {
+			if prices[i].Int64() != p {
+				t.Fatalf("MedianPrice(%v) reordered its input", c.prices)
+			}
+		}
+	}
+	if got := cpop.MedianPrice(nil); got != nil {
+		t.Errorf("MedianPrice(nil) = %v, want nil", got)
+	}
+}
+
+func TestPriceDeviationMatchesOracle(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil) // 500 bps threshold
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := &cpop.GasPriceOracleRaw{Contract: core.GasPriceOracle}
+
+	for _, old := range []int64{10_000, 30_001, 1_999, 7} {
+		// A new feed has a fresh price the updates are checked against.
+		feed := fmt.Sprintf("TEST/%d", old)
+		tx, err := core.GasPriceOracle.AddPriceFeed(auth, feed, big.NewInt(old), big.NewInt(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+		// Prices around the 500 bps boundary on both sides, where the
+		// integer division decides.
+		span := old / 20
+		for _, price := range []int64{old - span - 2, old - span - 1, old - span, old - span + 1, old + span - 1, old + span, old + span + 1, old + span + 2, old + span + 3} {
+			if price <= 0 {
+				continue
+			}
+			deviation := cpop.PriceDeviation(big.NewInt(old), big.NewInt(price))
+			var out []interface{}
+			err := raw.Call(&bind.CallOpts{From: auth.From}, &out, "updatePriceFeed", feed, big.NewInt(price))
+			if accepted := err == nil; accepted != (deviation <= 500) {
+				t.Errorf("%d -> %d: PriceDeviation %d bps, oracle error %v", old, price, deviation, cpop.AsRevertError(err))
+			}
+			if err != nil {
+				if revert, ok := cpop.DecodeRevert(err); !ok || revert.Reason != "price deviation too high" {
+					t.Errorf("%d -> %d: %v", old, price, err)
+				}
+			}
+		}
+	}
+	if got := cpop.PriceDeviation(big.NewInt(0), big.NewInt(5)); got != 0 {
+		t.Errorf("deviation from zero = %d, want 0", got)
+	}
+}
+
+// priceKeeperTest is a GasPriceOracle of the core stack owned by the keeper
+// account, with three stand-in sources.
+type priceKeeperTest struct {
+	t       *testing.T
+	sim     *simulated.Backend
+	auth    *bind.TransactOpts
+	core    *cpoptest.Core
+	sources []*cpoptest.PriceSource
+}
+
+func newPriceKeeperTest(t *testing.T) *priceKeeperTest {
+	t.Helper()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	t.Cleanup(func() { sim.Close() })
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil) // 3600 s max age, 500 bps threshold
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &priceKeeperTest{t: t, sim: sim, auth: auth, core: core}
+	for _, name := range []string{"a", "b", "c"} {
+		p.sources = append(p.sources, cpoptest.NewPriceSource(name))
+	}
+	return p
+}
+
+func (p *priceKeeperTest) keeper(config cpop.PriceKeeperConfig) *cpop.PriceKeeper {
+	p.t.Helper()
+	sources := make([]cpop.PriceSource, len(p.sources))
+	for i, s := range p.sources {
+		sources[i] = s
+	}
+	keeper, err := cpop.NewPriceKeeper(p.core.Addresses.GasPriceOracle, p.sim.Client(), p.auth, sources, config)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return keeper
+}
+
+// set sets the feed price of the sources, in source order.
+func (p *priceKeeperTest) set(feed string, prices ...int64) {
+	for i, price := range prices {
+		p.sources[i].Set(feed, big.NewInt(price))
+	}
+}
+
+// tick runs a keeper tick and checks the kinds of its alerts.
+func (p *priceKeeperTest) tick(keeper *cpop.PriceKeeper, alerts ...cpop.PriceAlertKind) []*cpop.PriceUpdate {
+	p.t.Helper()
+	sent, got, err := keeper.Tick(context.Background())
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	kinds := []cpop.PriceAlertKind{}
+	for _, a := range got {
+		kinds = append(kinds, a.Kind)
+	}
+	if alerts == nil {
+		alerts = []cpop.PriceAlertKind{}
+	}
+	if !reflect.DeepEqual(kinds, alerts) {
+		p.t.Fatalf("alerts %v, want %v", got, alerts)
+	}
+	return sent
+}
+
+// mine commits a block and checks the update was applied.
+func (p *priceKeeperTest) mine(u *cpop.PriceUpdate) {
+	p.t.Helper()
+	if err := cpoptest.Mine(p.sim, u.Tx); err != nil {
+		p.t.Fatal(err)
+	}
+	current, err := p.core.GasPriceOracle.GetPriceWithMetadata(nil, u.Feed)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	if !current.IsValid || current.Price.Cmp(u.NewPrice) != 0 {
+		p.t.Fatalf("%s price %s valid %v after update to %s", u.Feed, current.Price, current.IsValid, u.NewPrice)
+	}
+}
+
+func TestPriceKeeper(t *testing.T) {
+	p := newPriceKeeperTest(t)
+	keeper := p.keeper(cpop.PriceKeeperConfig{Feeds: []string{cpop.PriceFeedETHUSD}, MinSources: 2, MinChange: 50})
+	eth := cpop.PriceFeedETHUSD
+
+	// The feed starts without a price and gets the median.
+	p.set(eth, 2010, 2000, 1990)
+	sent := p.tick(keeper, cpop.PriceAlertInvalid)
+	if len(sent) != 1 || sent[0].NewPrice.Int64() != 2000 || sent[0].OldPrice != nil || sent[0].Refresh {
+		t.Fatalf("first update %+v", sent)
+	}
+	// Nothing is sent while the update is pending.
+	if sent := p.tick(keeper, cpop.PriceAlertInvalid); len(sent) != 0 || len(keeper.Pending()) != 1 {
+		t.Fatalf("sent %v while pending", sent)
+	}
+	p.mine(sent[0])
+	if sent := p.tick(keeper); len(sent) != 0 || len(keeper.Pending()) != 0 {
+		t.Fatalf("sent %v for an unchanged price", sent)
+	}
+
+	// 25 bps is below MinChange, 100 bps is above.
+	p.set(eth, 2005, 2004, 2006)
+	if sent := p.tick(keeper); len(sent) != 0 {
+		t.Fatalf("sent %v below MinChange", sent)
+	}
+	p.set(eth, 2020, 2020, 2020)
+	sent = p.tick(keeper)
+	if len(sent) != 1 || sent[0].OldPrice.Int64() != 2000 || sent[0].Deviation != 100 || sent[0].Refresh {
+		t.Fatalf("update %+v", sent)
+	}
+	p.mine(sent[0])
+
+	// Beyond the oracle threshold the update would revert, so only an
+	// alert is raised.
+	p.set(eth, 2500, 2500, 2500)
+	if sent := p.tick(keeper, cpop.PriceAlertDeviation); len(sent) != 0 || len(keeper.Pending()) != 0 {
+		t.Fatalf("sent %v beyond the deviation threshold", sent)
+	}
+
+	// An unchanged price is pushed again when it is about to go stale: the
+	// default RefreshBefore is a tenth of the 3600 s maximum age.
+	p.set(eth, 2020, 2020, 2020)
+	if err := p.sim.AdjustTime(3000 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	p.sim.Commit()
+	if sent := p.tick(keeper); len(sent) != 0 {
+		t.Fatalf("refreshed %v too early", sent)
+	}
+	if err := p.sim.AdjustTime(300 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	p.sim.Commit()
+	sent = p.tick(keeper)
+	if len(sent) != 1 || !sent[0].Refresh || sent[0].Deviation != 0 || sent[0].NewPrice.Int64() != 2020 {
+		t.Fatalf("refresh %+v", sent)
+	}
+	p.mine(sent[0])
+
+	// With one source left MinSources is not met.
+	failure := errors.New("down")
+	p.sources[0].Fail(failure)
+	p.sources[1].Fail(failure)
+	p.set(eth, 3000, 3000, 2100)
+	if sent := p.tick(keeper, cpop.PriceAlertSources); len(sent) != 0 {
+		t.Fatalf("sent %v without enough sources", sent)
+	}
+	p.sources[1].Fail(nil)
+	if sent := p.tick(keeper, cpop.PriceAlertDeviation); len(sent) != 0 {
+		t.Fatalf("sent %v beyond the deviation threshold", sent)
+	}
+}
+
+func TestPriceKeeperFeedAlerts(t *testing.T) {
+	p := newPriceKeeperTest(t)
+	const bnb = "BNB/USD" // never added to the oracle
+	keeper := p.keeper(cpop.PriceKeeperConfig{Feeds: []string{cpop.PriceFeedCPOPUSD, bnb}})
+	p.set(cpop.PriceFeedCPOPUSD, 50, 50, 50)
+	p.set(bnb, 600, 600, 600)
+
+	// Readers of a feed without a price get its fallback price.
+	tx, err := p.core.GasPriceOracle.SetFallbackPrice(p.auth, cpop.PriceFeedCPOPUSD, big.NewInt(40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cpoptest.Mine(p.sim, tx); err != nil {
+		t.Fatal(err)
+	}
+
+	_, alerts, err := keeper.Tick(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for _, a := range alerts {
+		kinds = append(kinds, a.Feed+" "+a.Kind.String())
+	}
+	want := []string{"CPOP/USD invalid", "CPOP/USD fallback-used", "BNB/USD invalid", "BNB/USD inactive"}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("alerts %v, want %v", kinds, want)
+	}
+	if alerts[1].Event != nil {
+		t.Error("fallback alert carries an event")
+	}
+	// Only the active feed is updated.
+	pending := keeper.Pending()
+	if len(pending) != 1 || pending[0].Feed != cpop.PriceFeedCPOPUSD {
+		t.Fatalf("pending %v", pending)
+	}
+	p.mine(pending[0])
+}
@@ -2,6 +2,58 @@
 
 # CPOP Contract ABI to Go bindings generator
 # Usage: ./generate-bindings.sh
+#
+# Run `yarn compile` in the repository root first so that the creation
+# bytecode can be extracted from the Hardhat artifacts. The <Type>.bin files
+# are embedded into the package and back the DeployX functions in deploy.go.
+
+ARTIFACTS="../artifacts"
+
+# extract_bin <Type> <artifact path relative to $ARTIFACTS>
+extract_bin() {
+    local artifact="$ARTIFACTS/$2"
+    if [[ -f "$artifact" ]]; then
+        node -e "process.stdout.write(require(require('path').resolve(process.argv[1])).bytecode)" "$artifact" > "$1.bin"
+    else
+        echo "Warning: $artifact not found, keeping existing $1.bin"
+    fi
+}
+
+echo "Extracting creation bytecode from Hardhat artifacts..."
+
+extract_bin CPOPToken contracts/CPP/CPPToken.sol/CPPToken.json
+extract_bin AAccount contracts/CPP/AAccount.sol/AAccount.json
+extract_bin AccountManager contracts/CPP/AccountManager.sol/AccountManager.json
+extract_bin MasterAggregator contracts/CPP/MasterAggregator.sol/MasterAggregator.json
+extract_bin GasPaymaster contracts/CPP/GasPaymaster.sol/GasPaymaster.json
+extract_bin GasPriceOracle contracts/CPP/GasPriceOracle.sol/GasPriceOracle.json
+extract_bin SessionKeyManager contracts/CPP/SessionKeyManager.sol/SessionKeyManager.json
+extract_bin Payment contracts/Payment.sol/Payment.json
+extract_bin MockUSDT contracts/MockUSDT.sol/MockUSDT.json
+extract_bin CPNFT contracts/CPNFT/CPNFT.sol/CPNFT.json
+extract_bin Staking contracts/CPNFT/Staking.sol/Staking.json
+extract_bin StakingReader contracts/CPNFT/StakingReader.sol/StakingReader.json
+extract_bin StakingConfig contracts/CPNFT/StakingConfig.sol/StakingConfig.json
+extract_bin Marketplace contracts/C2C/Marketplace.sol/Marketplace.json
+extract_bin ChapoolEarnVault contracts/EARN/ChapoolEarnVault.sol/ChapoolEarnVault.json
+extract_bin VeCPOTLocker contracts/EARN/VeCPOTLocker.sol/VeCPOTLocker.json
+extract_bin NFTBoostController contracts/EARN/NFTBoostController.sol/NFTBoostController.json
+extract_bin ChapoolRewardDistributor contracts/EARN/ChapoolRewardDistributor.sol/ChapoolRewardDistributor.json
+extract_bin ChapoolVaultReader contracts/EARN/ChapoolVaultReader.sol/ChapoolVaultReader.json
+extract_bin BatchTransfer contracts/utils/BatchTransfer.sol/BatchTransfer.json
+extract_bin EntryPoint contracts/core/EntryPoint.sol/EntryPoint.json
+extract_bin ERC1967Proxy @openzeppelin/contracts/proxy/ERC1967/ERC1967Proxy.sol/ERC1967Proxy.json
+
+echo "Copying deployment manifests..."
+
+for network in opbnb opbnbTestnet bnbTestnet; do
+    mkdir -p "deployments/$network"
+    for manifest in core.json earn.json; do
+        if [[ -f "../deployments/$network/$manifest" ]]; then
+            cp "../deployments/$network/$manifest" "deployments/$network/$manifest"
+        fi
+    done
+done
 
 echo "Generating Go bindings for CPOP contracts..."
 
@@ -18,7 +70,16 @@ abigen --abi MockUSDT.abi.json --pkg cpop --type MockUSDT --out "mock_usdt.go"
 abigen --abi CPNFT.abi.json --pkg cpop --type CPNFT --out "cp_nft.go"
 abigen --abi Staking.abi.json --pkg cpop --type Staking --out "staking.go"
 abigen --abi StakingReader.abi.json --pkg cpop --type StakingReader --out "staking_reader.go"
+abigen --abi StakingConfig.abi.json --pkg cpop --type StakingConfig --out "staking_config.go"
 abigen --abi Marketplace.abi.json --pkg cpop --type Marketplace --out "marketplace.go"
+abigen --abi ChapoolEarnVault.abi.json --pkg cpop --type ChapoolEarnVault --out "chapool_earn_vault.go"
+abigen --abi VeCPOTLocker.abi.json --pkg cpop --type VeCPOTLocker --out "ve_cpot_locker.go"
+abigen --abi NFTBoostController.abi.json --pkg cpop --type NFTBoostController --out "nft_boost_controller.go"
+abigen --abi ChapoolRewardDistributor.abi.json --pkg cpop --type ChapoolRewardDistributor --out "chapool_reward_distributor.go"
+abigen --abi ChapoolVaultReader.abi.json --pkg cpop --type ChapoolVaultReader --out "chapool_vault_reader.go"
+abigen --abi BatchTransfer.abi.json --pkg cpop --type BatchTransfer --out "batch_transfer.go"
+abigen --abi EntryPoint.abi.json --pkg cpop --type EntryPoint --out "entry_point.go"
+abigen --abi ERC1967Proxy.abi.json --pkg cpop --type ERC1967Proxy --out "erc1967_proxy.go"
 
 echo "Fixing duplicate type definitions..."
 ./fix-duplicates.sh
@@ -0,0 +1,427 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultIndexerBatchSize is the number of blocks an Indexer queries per
+	// eth_getLogs call when no batch size is configured.
+	DefaultIndexerBatchSize = 2000
+	// DefaultIndexerReorgDepth is the number of blocks an Indexer keeps
+	// checkpoints and logs for when no reorg depth is configured.
+	DefaultIndexerReorgDepth = 128
+	// DefaultIndexerPollInterval is the interval Indexer.Run polls the node
+	// with when no interval is configured.
+	DefaultIndexerPollInterval = 5 * time.Second
+)
+
+var (
+	// ErrUnknownEvent is returned by EventDecoder.Decode for logs that are
+	// not emitted by a decoded contract.
+	ErrUnknownEvent = errors.New("unknown event")
+	// ErrReorgTooDeep is returned by Indexer.Sync when none of the stored
+	// checkpoints is on the canonical chain any more.
+	ErrReorgTooDeep = errors.New("reorg deeper than stored checkpoints")
+)
+
+// eventFilterers maps the binding type name to its filterer constructor,
+// whose Parse methods decode the contract events.
+var eventFilterers = map[string]func(common.Address, bind.ContractFilterer) (any, error){
+	"AAccount":                 filtererOf(NewAAccountFilterer),
+	"AccountManager":           filtererOf(NewAccountManagerFilterer),
+	"BatchTransfer":            filtererOf(NewBatchTransferFilterer),
+	"CPNFT":                    filtererOf(NewCPNFTFilterer),
+	"CPOPToken":                filtererOf(NewCPOPTokenFilterer),
+	"ChapoolEarnVault":         filtererOf(NewChapoolEarnVaultFilterer),
+	"ChapoolRewardDistributor": filtererOf(NewChapoolRewardDistributorFilterer),
+	"ChapoolVaultReader":       filtererOf(NewChapoolVaultReaderFilterer),
+	"EntryPoint":               filtererOf(NewEntryPointFilterer),
+	"GasPaymaster":             filtererOf(NewGasPaymasterFilterer),
+	"GasPriceOracle":           filtererOf(NewGasPriceOracleFilterer),
+	"Marketplace":              filtererOf(NewMarketplaceFilterer),
+	"MasterAggregator":         filtererOf(NewMasterAggregatorFilterer),
+	"MockUSDT":                 filtererOf(NewMockUSDTFilterer),
+	"NFTBoostController":       filtererOf(NewNFTBoostControllerFilterer),
+	"Payment":                  filtererOf(NewPaymentFilterer),
+	"SessionKeyManager":        filtererOf(NewSessionKeyManagerFilterer),
+	"Staking":                  filtererOf(NewStakingFilterer),
+	"StakingConfig":            filtererOf(NewStakingConfigFilterer),
+	"StakingReader":            filtererOf(NewStakingReaderFilterer),
+	"VeCPOTLocker":             filtererOf(NewVeCPOTLockerFilterer),
+}
+
+// filtererOf adapts a typed filterer constructor to eventFilterers.
+func filtererOf[T any](newFilterer func(common.Address, bind.ContractFilterer) (*T, error)) func(common.Address, bind.ContractFilterer) (any, error) {
+	return func(addr common.Address, filterer bind.ContractFilterer) (any, error) {
+		return newFilterer(addr, filterer)
+	}
+}
+
+// IndexedEvent is a contract event decoded with its binding.
+type IndexedEvent struct {
+	Contract string // binding type name, e.g. "Staking"
+	Name     string // ABI event name, e.g. "NFTStaked"
+	Event    any    // binding event, e.g. *StakingNFTStaked
+
+	// Log is the raw log. Log.Removed is set when the event is re-emitted
+	// because its block left the canonical chain.
+	Log types.Log
+}
+
+// eventParser decodes one event of a contract.
+type eventParser struct {
+	contract string
+	name     string
+	parse    reflect.Value // func(types.Log) (*<Contract><Event>, error)
+}
+
+// EventDecoder decodes the logs of a set of deployed contracts into their
+// typed binding events.
+type EventDecoder struct {
+	contracts map[common.Address]string
+	parsers   map[common.Address]map[common.Hash]eventParser
+}
+
+// NewEventDecoder returns a decoder for the named contracts of m, or for
+// every bound contract of m when no names are given.
+func NewEventDecoder(m *Manifest, names ...string) (*EventDecoder, error) {
+	if len(names) == 0 {
+		for name := range eventFilterers {
+			if _, ok := m.Address(name); ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+	}
+	d := &EventDecoder{
+		contracts: make(map[common.Address]string),
+		parsers:   make(map[common.Address]map[common.Hash]eventParser),
+	}
+	for _, name := range names {
+		addr, ok := m.Address(name)
+		if !ok {
+			return nil, fmt.Errorf("%s: not deployed on %s", name, m.Network)
+		}
+		if err := d.Add(name, addr); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Add registers the contract with the given binding type name at addr.
+func (d *EventDecoder) Add(name string, addr common.Address) error {
+	newFilterer, ok := eventFilterers[name]
+	if !ok {
+		return fmt.Errorf("unknown contract %q", name)
+	}
+	parsed, err := contractMetaData[name].GetAbi()
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	filterer, err := newFilterer(addr, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	parsers := make(map[common.Hash]eventParser, len(parsed.Events))
+	for _, event := range parsed.Events {
+		method := reflect.ValueOf(filterer).MethodByName("Parse" + abi.ToCamelCase(event.Name))
+		if !method.IsValid() {
+			return fmt.Errorf("%s: no parser for event %s", name, event.Name)
+		}
+		parsers[event.ID] = eventParser{contract: name, name: event.Name, parse: method}
+	}
+	d.contracts[addr] = name
+	d.parsers[addr] = parsers
+	return nil
+}
+
+// Addresses returns the addresses of the decoded contracts.
+func (d *EventDecoder) Addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(d.contracts))
+	for addr := range d.contracts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].Cmp(addrs[j]) < 0 })
+	return addrs
+}
+
+// Topics returns the IDs of every decoded event, for use as the first topic
+// of a filter query.
+func (d *EventDecoder) Topics() []common.Hash {
+	seen := make(map[common.Hash]bool)
+	var topics []common.Hash
+	for _, addr := range d.Addresses() {
+		for id := range d.parsers[addr] {
+			if !seen[id] {
+				seen[id] = true
+				topics = append(topics, id)
+			}
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Cmp(topics[j]) < 0 })
+	return topics
+}
+
+// Decode decodes log with the binding of the contract that emitted it.
+func (d *EventDecoder) Decode(log types.Log) (*IndexedEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: anonymous log of %s", ErrUnknownEvent, log.Address)
+	}
+	p, ok := d.parsers[log.Address][log.Topics[0]]
+	if !ok {
+		return nil, fmt.Errorf("%w: topic %s of %s", ErrUnknownEvent, log.Topics[0], log.Address)
+	}
+	out := p.parse.Call([]reflect.Value{reflect.ValueOf(log)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", p.contract, p.name, err)
+	}
+	return &IndexedEvent{Contract: p.contract, Name: p.name, Event: out[0].Interface(), Log: log}, nil
+}
+
+// Checkpoint is an indexed block.
+type Checkpoint struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// IndexerStore persists the progress of an Indexer. Besides the checkpoints
+// it keeps the logs of the recent blocks, so they can be re-emitted as
+// removed when a reorg drops their blocks.
+type IndexerStore interface {
+	// Checkpoints returns the stored checkpoints in ascending block order.
+	Checkpoints(ctx context.Context) ([]Checkpoint, error)
+	// Commit atomically stores checkpoints and the logs of their blocks.
+	Commit(ctx context.Context, checkpoints []Checkpoint, logs []types.Log) error
+	// Logs returns the stored logs above block number in chain order.
+	Logs(ctx context.Context, number uint64) ([]types.Log, error)
+	// Rewind deletes the checkpoints and logs above block number.
+	Rewind(ctx context.Context, number uint64) error
+	// Prune deletes the checkpoints and logs below block number.
+	Prune(ctx context.Context, number uint64) error
+}
+
+// IndexerBackend is the node interface an Indexer needs, implemented by
+// *ethclient.Client and the simulated backend client.
+type IndexerBackend interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// IndexerConfig configures an Indexer. Zero values select the defaults.
+type IndexerConfig struct {
+	Contracts     []string      // binding type names, all deployed contracts if empty
+	FromBlock     uint64        // first block to index when the store is empty
+	BatchSize     uint64        // blocks per log query
+	Confirmations uint64        // blocks to stay behind the head
+	ReorgDepth    uint64        // blocks to keep checkpoints and logs for
+	PollInterval  time.Duration // interval of Run
+}
+
+// Indexer tails the events of the contracts of a deployment manifest,
+// decodes them with their bindings and checkpoints its progress in an
+// IndexerStore.
+//
+// Events are delivered at least once: a batch is committed to the store only
+// after the handler accepted all of its events. When a reorg replaces indexed
+// blocks, the events of the dropped blocks are re-emitted in reverse order
+// with Log.Removed set, before the events of the new canonical blocks.
+type Indexer struct {
+	backend IndexerBackend
+	store   IndexerStore
+	decoder *EventDecoder
+	config  IndexerConfig
+}
+
+// NewIndexer returns an indexer for the contracts of m selected by config.
+func NewIndexer(backend IndexerBackend, m *Manifest, store IndexerStore, config IndexerConfig) (*Indexer, error) {
+	decoder, err := NewEventDecoder(m, config.Contracts...)
+	if err != nil {
+		return nil, err
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultIndexerBatchSize
+	}
+	if config.ReorgDepth == 0 {
+		config.ReorgDepth = DefaultIndexerReorgDepth
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = DefaultIndexerPollInterval
+	}
+	return &Indexer{backend: backend, store: store, decoder: decoder, config: config}, nil
+}
+
+// Decoder returns the event decoder of the indexer.
+func (ix *Indexer) Decoder() *EventDecoder {
+	return ix.decoder
+}
+
+// Run calls Sync every poll interval until ctx is cancelled or Sync fails.
+func (ix *Indexer) Run(ctx context.Context, handle func(*IndexedEvent) error) error {
+	ticker := time.NewTicker(ix.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := ix.Sync(ctx, handle); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync indexes the blocks from the last checkpoint up to the confirmed head,
+// passing every event to handle, and returns the last indexed block.
+func (ix *Indexer) Sync(ctx context.Context, handle func(*IndexedEvent) error) (uint64, error) {
+	head, err := ix.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("head: %w", err)
+	}
+	checkpoints, err := ix.store.Checkpoints(ctx)
+	if err != nil {
+		return 0, err
+	}
+	from := ix.config.FromBlock
+	if len(checkpoints) > 0 {
+		ancestor, err := ix.commonAncestor(ctx, checkpoints)
+		if err != nil {
+			return 0, err
+		}
+		if ancestor < checkpoints[len(checkpoints)-1].Number {
+			if err := ix.rewind(ctx, ancestor, handle); err != nil {
+				return 0, err
+			}
+		}
+		from = ancestor + 1
+	}
+
+	var last uint64
+	if from > 0 {
+		last = from - 1
+	}
+	if head.Number.Uint64() < ix.config.Confirmations {
+		return last, nil
+	}
+	to := head.Number.Uint64() - ix.config.Confirmations
+	// Every block kept after pruning is checkpointed, so that a reorg of
+	// less than ReorgDepth blocks always finds a common ancestor.
+	var keepFrom uint64
+	if to >= ix.config.ReorgDepth {
+		keepFrom = to - ix.config.ReorgDepth + 1
+	}
+	for ; from <= to; from += ix.config.BatchSize {
+		end := min(from+ix.config.BatchSize-1, to)
+		if err := ix.index(ctx, from, end, keepFrom, handle); err != nil {
+			return last, err
+		}
+		last = end
+	}
+	if last >= ix.config.ReorgDepth {
+		if err := ix.store.Prune(ctx, last-ix.config.ReorgDepth+1); err != nil {
+			return last, err
+		}
+	}
+	return last, nil
+}
+
+// commonAncestor returns the highest checkpoint still on the canonical chain.
+func (ix *Indexer) commonAncestor(ctx context.Context, checkpoints []Checkpoint) (uint64, error) {
+	for i := len(checkpoints) - 1; i >= 0; i-- {
+		cp := checkpoints[i]
+		header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(cp.Number))
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("block %d: %w", cp.Number, err)
+		}
+		if header.Hash() == cp.Hash {
+			return cp.Number, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: oldest checkpoint %d", ErrReorgTooDeep, checkpoints[0].Number)
+}
+
+// rewind re-emits the events above block ancestor as removed, newest first,
+// and then rolls the store back to ancestor. The store is only rolled back
+// once the handler accepted every removal, so a failed rewind is repeated by
+// the next Sync.
+func (ix *Indexer) rewind(ctx context.Context, ancestor uint64, handle func(*IndexedEvent) error) error {
+	logs, err := ix.store.Logs(ctx, ancestor)
+	if err != nil {
+		return err
+	}
+	for i := len(logs) - 1; i >= 0; i-- {
+		log := logs[i]
+		log.Removed = true
+		event, err := ix.decoder.Decode(log)
+		if err != nil {
+			return err
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+	return ix.store.Rewind(ctx, ancestor)
+}
+
+// index delivers the events of blocks from to end and commits them. Blocks
+// with logs, the end block and every block from keepFrom on are
+// checkpointed.
+func (ix *Indexer) index(ctx context.Context, from, end, keepFrom uint64, handle func(*IndexedEvent) error) error {
+	hashes := make(map[uint64]common.Hash)
+	for n := min(max(from, keepFrom), end); n <= end; n++ {
+		header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return fmt.Errorf("block %d: %w", n, err)
+		}
+		hashes[n] = header.Hash()
+	}
+	logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(end),
+		Addresses: ix.decoder.Addresses(),
+		Topics:    [][]common.Hash{ix.decoder.Topics()},
+	})
+	if err != nil {
+		return fmt.Errorf("logs %d-%d: %w", from, end, err)
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	for _, log := range logs {
+		hashes[log.BlockNumber] = log.BlockHash
+		event, err := ix.decoder.Decode(log)
+		if err != nil {
+			return err
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+	checkpoints := make([]Checkpoint, 0, len(hashes))
+	for number, hash := range hashes {
+		checkpoints = append(checkpoints, Checkpoint{Number: number, Hash: hash})
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].Number < checkpoints[j].Number })
+	return ix.store.Commit(ctx, checkpoints, logs)
+}
@@ -0,0 +1,230 @@
+package cpop
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MemoryIndexerStore is an IndexerStore kept in memory, for tests and
+// short-lived indexers.
+type MemoryIndexerStore struct {
+	mu          sync.Mutex
+	checkpoints map[uint64]common.Hash
+	logs        map[uint64][]types.Log
+}
+
+// NewMemoryIndexerStore returns an empty in-memory store.
+func NewMemoryIndexerStore() *MemoryIndexerStore {
+	return &MemoryIndexerStore{
+		checkpoints: make(map[uint64]common.Hash),
+		logs:        make(map[uint64][]types.Log),
+	}
+}
+
+// Checkpoints implements IndexerStore.
+func (s *MemoryIndexerStore) Checkpoints(ctx context.Context) ([]Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints := make([]Checkpoint, 0, len(s.checkpoints))
+	for number, hash := range s.checkpoints {
+		checkpoints = append(checkpoints, Checkpoint{Number: number, Hash: hash})
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].Number < checkpoints[j].Number })
+	return checkpoints, nil
+}
+
+// Commit implements IndexerStore.
+func (s *MemoryIndexerStore) Commit(ctx context.Context, checkpoints []Checkpoint, logs []types.Log) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, cp := range checkpoints {
+		s.checkpoints[cp.Number] = cp.Hash
+		delete(s.logs, cp.Number)
+	}
+	for _, log := range logs {
+		s.logs[log.BlockNumber] = append(s.logs[log.BlockNumber], log)
+	}
+	return nil
+}
+
+// Logs implements IndexerStore.
+func (s *MemoryIndexerStore) Logs(ctx context.Context, number uint64) ([]types.Log, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var blocks []uint64
+	for n := range s.checkpoints {
+		if n > number {
+			blocks = append(blocks, n)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	var logs []types.Log
+	for _, n := range blocks {
+		logs = append(logs, s.logs[n]...)
+	}
+	return logs, nil
+}
+
+// Rewind implements IndexerStore.
+func (s *MemoryIndexerStore) Rewind(ctx context.Context, number uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for n := range s.checkpoints {
+		if n > number {
+			delete(s.checkpoints, n)
+			delete(s.logs, n)
+		}
+	}
+	return nil
+}
+
+// Prune implements IndexerStore.
+func (s *MemoryIndexerStore) Prune(ctx context.Context, number uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for n := range s.checkpoints {
+		if n < number {
+			delete(s.checkpoints, n)
+			delete(s.logs, n)
+		}
+	}
+	return nil
+}
+
+// SQLiteIndexerStore is an IndexerStore in a SQLite database. The database is
+// opened by the caller with the driver of their choice, e.g.
+// sql.Open("sqlite", path) with modernc.org/sqlite or sql.Open("sqlite3",
+// path) with github.com/mattn/go-sqlite3.
+type SQLiteIndexerStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteIndexerStore creates the indexer tables in db if needed.
+func NewSQLiteIndexerStore(ctx context.Context, db *sql.DB) (*SQLiteIndexerStore, error) {
+	for _, stmt := range []string{
+		`CREATE TABLE IF NOT EXISTS cpop_indexer_checkpoints (
+			number INTEGER PRIMARY KEY,
+			hash   TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS cpop_indexer_logs (
+			block_number INTEGER NOT NULL,
+			log_index    INTEGER NOT NULL,
+			log          TEXT NOT NULL,
+			PRIMARY KEY (block_number, log_index)
+		)`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("create indexer tables: %w", err)
+		}
+	}
+	return &SQLiteIndexerStore{db: db}, nil
+}
+
+// Checkpoints implements IndexerStore.
+func (s *SQLiteIndexerStore) Checkpoints(ctx context.Context) ([]Checkpoint, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT number, hash FROM cpop_indexer_checkpoints ORDER BY number`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var checkpoints []Checkpoint
+	for rows.Next() {
+		var (
+			number int64
+			hash   string
+		)
+		if err := rows.Scan(&number, &hash); err != nil {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, Checkpoint{Number: uint64(number), Hash: common.HexToHash(hash)})
+	}
+	return checkpoints, rows.Err()
+}
+
+// Commit implements IndexerStore.
+func (s *SQLiteIndexerStore) Commit(ctx context.Context, checkpoints []Checkpoint, logs []types.Log) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, cp := range checkpoints {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM cpop_indexer_logs WHERE block_number = ?`, int64(cp.Number)); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO cpop_indexer_checkpoints (number, hash) VALUES (?, ?)`, int64(cp.Number), cp.Hash.Hex()); err != nil {
+			return err
+		}
+	}
+	for _, log := range logs {
+		data, err := json.Marshal(&log)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO cpop_indexer_logs (block_number, log_index, log) VALUES (?, ?, ?)`, int64(log.BlockNumber), int64(log.Index), string(data)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Logs implements IndexerStore.
+func (s *SQLiteIndexerStore) Logs(ctx context.Context, number uint64) ([]types.Log, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT log FROM cpop_indexer_logs WHERE block_number > ? ORDER BY block_number, log_index`, int64(number))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var logs []types.Log
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var log types.Log
+		if err := json.Unmarshal([]byte(data), &log); err != nil {
+			return nil, fmt.Errorf("stored log: %w", err)
+		}
+		logs = append(logs, log)
+	}
+	return logs, rows.Err()
+}
+
+// Rewind implements IndexerStore.
+func (s *SQLiteIndexerStore) Rewind(ctx context.Context, number uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cpop_indexer_logs WHERE block_number > ?`, int64(number)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cpop_indexer_checkpoints WHERE number > ?`, int64(number)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Prune implements IndexerStore.
+func (s *SQLiteIndexerStore) Prune(ctx context.Context, number uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cpop_indexer_logs WHERE block_number < ?`, int64(number)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM cpop_indexer_checkpoints WHERE number < ?`, int64(number)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,273 @@
+package cpop_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	_ "github.com/mattn/go-sqlite3"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// indexerStores returns a constructor for each IndexerStore implementation.
+func indexerStores(t *testing.T) map[string]func() cpop.IndexerStore {
+	return map[string]func() cpop.IndexerStore{
+		"memory": func() cpop.IndexerStore { return cpop.NewMemoryIndexerStore() },
+		"sqlite": func() cpop.IndexerStore {
+			db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "indexer.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { db.Close() })
+			store, err := cpop.NewSQLiteIndexerStore(context.Background(), db)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return store
+		},
+	}
+}
+
+func testLog(block uint64, index uint, topic byte) types.Log {
+	return types.Log{
+		Address:     common.HexToAddress("0x01"),
+		Topics:      []common.Hash{{topic}},
+		Data:        []byte{topic},
+		BlockNumber: block,
+		BlockHash:   common.Hash{byte(block)},
+		TxHash:      common.Hash{byte(block), byte(index)},
+		Index:       index,
+	}
+}
+
+func TestIndexerStore(t *testing.T) {
+	ctx := context.Background()
+	for name, newStore := range indexerStores(t) {
+		t.Run(name, func(t *testing.T) {
+			store := newStore()
+			checkpoints := []cpop.Checkpoint{{Number: 1, Hash: common.Hash{1}}, {Number: 2, Hash: common.Hash{2}}, {Number: 3, Hash: common.Hash{3}}}
+			logs := []types.Log{testLog(2, 0, 0xa), testLog(3, 1, 0xb), testLog(3, 2, 0xc)}
+			if err := store.Commit(ctx, checkpoints, logs); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := store.Checkpoints(ctx); err != nil || !reflect.DeepEqual(got, checkpoints) {
+				t.Fatalf("checkpoints = %v, %v", got, err)
+			}
+
+			// Logs reads without deleting.
+			for range 2 {
+				got, err := store.Logs(ctx, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, logs) {
+					t.Fatalf("logs above 1 = %v, want %v", got, logs)
+				}
+			}
+			if got, err := store.Logs(ctx, 2); err != nil || !reflect.DeepEqual(got, logs[1:]) {
+				t.Fatalf("logs above 2 = %v, %v", got, err)
+			}
+
+			// Committing a block again replaces its logs.
+			replaced := testLog(3, 0, 0xd)
+			if err := store.Commit(ctx, checkpoints[2:], []types.Log{replaced}); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := store.Logs(ctx, 2); err != nil || !reflect.DeepEqual(got, []types.Log{replaced}) {
+				t.Fatalf("replaced logs = %v, %v", got, err)
+			}
+
+			if err := store.Rewind(ctx, 1); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := store.Checkpoints(ctx); err != nil || !reflect.DeepEqual(got, checkpoints[:1]) {
+				t.Fatalf("checkpoints after rewind = %v, %v", got, err)
+			}
+			if got, err := store.Logs(ctx, 0); err != nil || len(got) != 0 {
+				t.Fatalf("logs after rewind = %v, %v", got, err)
+			}
+
+			if err := store.Commit(ctx, checkpoints[1:], logs); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.Prune(ctx, 3); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := store.Checkpoints(ctx); err != nil || !reflect.DeepEqual(got, checkpoints[2:]) {
+				t.Fatalf("checkpoints after prune = %v, %v", got, err)
+			}
+			if got, err := store.Logs(ctx, 0); err != nil || !reflect.DeepEqual(got, logs[1:]) {
+				t.Fatalf("logs after prune = %v, %v", got, err)
+			}
+		})
+	}
+}
+
+// transferRecord is a MockUSDT Transfer seen by an indexer handler.
+type transferRecord struct {
+	value   int64
+	removed bool
+}
+
+func TestIndexerReorg(t *testing.T) {
+	ctx := context.Background()
+	for name, newStore := range indexerStores(t) {
+		t.Run(name, func(t *testing.T) {
+			key, _ := crypto.GenerateKey()
+			otherKey, _ := crypto.GenerateKey()
+			sim := cpoptest.NewBackend(key, otherKey)
+			defer sim.Close()
+			auth := cpoptest.NewTransactor(key)
+			client := sim.Client()
+			usdtAddr, tx, usdt, err := cpop.DeployMockUSDT(auth, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cpoptest.Mine(sim, tx); err != nil {
+				t.Fatal(err)
+			}
+			other := cpoptest.NewTransactor(otherKey)
+			if tx, err = usdt.Mint(auth, other.From, big.NewInt(100)); err != nil {
+				t.Fatal(err)
+			}
+			if err := cpoptest.Mine(sim, tx); err != nil {
+				t.Fatal(err)
+			}
+			sim.Commit()
+			base, err := client.HeaderByNumber(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			holder := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+			mine := func(tx *types.Transaction, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := cpoptest.Mine(sim, tx); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			store := newStore()
+			manifest := &cpop.Manifest{Network: "simulated", Contracts: map[string]common.Address{"MockUSDT": usdtAddr}}
+			ix, err := cpop.NewIndexer(client, manifest, store, cpop.IndexerConfig{FromBlock: base.Number.Uint64()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var seen []transferRecord
+			var failRemoved bool
+			handle := func(ev *cpop.IndexedEvent) error {
+				transfer, ok := ev.Event.(*cpop.MockUSDTTransfer)
+				if !ok {
+					t.Fatalf("event %s.%s", ev.Contract, ev.Name)
+				}
+				if ev.Log.Removed && failRemoved {
+					failRemoved = false
+					return errors.New("handler down")
+				}
+				seen = append(seen, transferRecord{transfer.Value.Int64(), ev.Log.Removed})
+				return nil
+			}
+
+			// The empty base block is checkpointed as the end of a batch.
+			if _, err := ix.Sync(ctx, handle); err != nil {
+				t.Fatal(err)
+			}
+			mine(usdt.Mint(auth, holder, big.NewInt(1)))
+			mine(usdt.Mint(auth, holder, big.NewInt(2)))
+			if _, err := ix.Sync(ctx, handle); err != nil {
+				t.Fatal(err)
+			}
+			if want := []transferRecord{{1, false}, {2, false}}; !reflect.DeepEqual(seen, want) {
+				t.Fatalf("before reorg: %v, want %v", seen, want)
+			}
+
+			// Replace both minting blocks by a longer chain in which another
+			// account transfers 3.
+			if err := sim.Fork(base.Hash()); err != nil {
+				t.Fatal(err)
+			}
+			mine(usdt.Transfer(other, holder, big.NewInt(3)))
+			sim.Commit()
+			sim.Commit()
+
+			// A handler failing on a removal leaves the store untouched, so
+			// the next Sync delivers the removals again.
+			seen, failRemoved = nil, true
+			if _, err := ix.Sync(ctx, handle); err == nil {
+				t.Fatal("Sync ignored the handler error")
+			}
+			if logs, err := store.Logs(ctx, base.Number.Uint64()); err != nil || len(logs) != 2 {
+				t.Fatalf("stored logs after failed rewind = %d, %v; want 2", len(logs), err)
+			}
+			seen = nil
+			if _, err := ix.Sync(ctx, handle); err != nil {
+				t.Fatal(err)
+			}
+			// The removals come newest first. The txpool puts the dropped
+			// mints back, so they are delivered again next to the transfer
+			// in an order of the block builder's choosing.
+			if len(seen) != 5 {
+				t.Fatalf("after reorg: %v, want 2 removals and 3 events", seen)
+			}
+			if want := []transferRecord{{2, true}, {1, true}}; !reflect.DeepEqual(seen[:2], want) {
+				t.Fatalf("removals: %v, want %v", seen[:2], want)
+			}
+			added := seen[2:]
+			sort.Slice(added, func(i, j int) bool { return added[i].value < added[j].value })
+			if want := []transferRecord{{1, false}, {2, false}, {3, false}}; !reflect.DeepEqual(added, want) {
+				t.Fatalf("new chain: %v, want %v", added, want)
+			}
+			if logs, err := store.Logs(ctx, base.Number.Uint64()); err != nil || len(logs) != 3 {
+				t.Fatalf("stored logs after reorg = %d, %v; want 3", len(logs), err)
+			}
+		})
+
+		// A catch-up Sync over empty blocks keeps a checkpoint for every
+		// block within the reorg depth, so a reorg of the head is survived.
+		t.Run(name+"/empty", func(t *testing.T) {
+			key, _ := crypto.GenerateKey()
+			sim := cpoptest.NewBackend(key)
+			defer sim.Close()
+			client := sim.Client()
+			for range 10 {
+				sim.Commit()
+			}
+			manifest := &cpop.Manifest{Network: "simulated", Contracts: map[string]common.Address{"MockUSDT": common.HexToAddress("0x01")}}
+			ix, err := cpop.NewIndexer(client, manifest, newStore(), cpop.IndexerConfig{ReorgDepth: 4})
+			if err != nil {
+				t.Fatal(err)
+			}
+			handle := func(ev *cpop.IndexedEvent) error {
+				t.Fatalf("event %s.%s", ev.Contract, ev.Name)
+				return nil
+			}
+			if _, err := ix.Sync(ctx, handle); err != nil {
+				t.Fatal(err)
+			}
+			parent, err := client.HeaderByNumber(ctx, big.NewInt(9))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := sim.Fork(parent.Hash()); err != nil {
+				t.Fatal(err)
+			}
+			sim.Commit()
+			sim.Commit()
+			if _, err := ix.Sync(ctx, handle); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
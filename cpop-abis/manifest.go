@@ -0,0 +1,355 @@
+package cpop
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// manifestFS holds the deployment manifests of the public networks, copied
+// from the repository deployments directory by generate-bindings.sh.
+//
+//go:embed deployments/*/core.json deployments/*/earn.json
+var manifestFS embed.FS
+
+var (
+	// ErrUnknownNetwork is returned when no core.json exists for a network.
+	ErrUnknownNetwork = errors.New("unknown network")
+	// ErrChainIDMismatch is returned when the dialed chain differs from the manifest.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+)
+
+// manifestAliases maps binding type names to the contract keys used by the
+// deployment scripts where the two differ.
+var manifestAliases = map[string]string{
+	"CPOPToken": "CPPToken",
+}
+
+// rewardLevels are the CPNFT levels in StakingConfig order, level 1 to 6.
+var rewardLevels = []string{"C", "B", "A", "S", "SS", "SSS"}
+
+// ConfigUpdate is an entry of the core.json configUpdates history, written by
+// the scripts that reconfigure contracts after deployment.
+type ConfigUpdate struct {
+	Type            string            `json:"type"`
+	Timestamp       time.Time         `json:"timestamp"`
+	TransactionHash common.Hash       `json:"transactionHash"`
+	BlockNumber     uint64            `json:"blockNumber"`
+	Rewards         map[string]string `json:"rewards,omitempty"` // daily CPP per level for "rewards" updates
+}
+
+// DailyRewards returns the rewards of a "rewards" update in wei, ordered as
+// the StakingConfig.updateRewards argument.
+func (u *ConfigUpdate) DailyRewards() ([6]*big.Int, error) {
+	var out [6]*big.Int
+	for i, level := range rewardLevels {
+		value, ok := u.Rewards[level]
+		if !ok {
+			return out, fmt.Errorf("config update %s: no reward for level %s", u.TransactionHash.Hex(), level)
+		}
+		amount, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return out, fmt.Errorf("config update %s: invalid reward %q for level %s", u.TransactionHash.Hex(), value, level)
+		}
+		amount.Mul(amount, new(big.Rat).SetInt(big.NewInt(1e18)))
+		if !amount.IsInt() {
+			return out, fmt.Errorf("config update %s: reward %q for level %s exceeds 18 decimals", u.TransactionHash.Hex(), value, level)
+		}
+		out[i] = new(big.Int).Set(amount.Num())
+	}
+	return out, nil
+}
+
+// EarnManifest is the content of earn.json. CoreRef repeats the core
+// contracts the EARN contracts were wired to.
+type EarnManifest struct {
+	Network   string                    `json:"network"`
+	ChainID   uint64                    `json:"chainId"`
+	Deployer  common.Address            `json:"deployer"`
+	Timestamp time.Time                 `json:"timestamp"`
+	Contracts map[string]common.Address `json:"contracts"`
+	CoreRef   map[string]common.Address `json:"coreRef"`
+}
+
+// Manifest is the content of core.json, merged with earn.json when the
+// network has one.
+type Manifest struct {
+	Network       string                    `json:"network"`
+	ChainID       uint64                    `json:"chainId"`
+	Deployer      common.Address            `json:"deployer"`
+	EntryPoint    common.Address            `json:"entryPoint"`
+	USDT          common.Address            `json:"usdtAddress"`
+	IsLocalTest   bool                      `json:"isLocalTest"`
+	Timestamp     time.Time                 `json:"timestamp"`
+	Contracts     map[string]common.Address `json:"contracts"`
+	ConfigUpdates []ConfigUpdate            `json:"configUpdates"`
+
+	Earn *EarnManifest `json:"-"`
+}
+
+// Networks returns the networks with an embedded manifest.
+func Networks() []string {
+	entries, _ := fs.ReadDir(manifestFS, "deployments")
+	networks := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			networks = append(networks, e.Name())
+		}
+	}
+	sort.Strings(networks)
+	return networks
+}
+
+// LoadManifest returns the embedded manifest of network.
+func LoadManifest(network string) (*Manifest, error) {
+	sub, err := fs.Sub(manifestFS, "deployments")
+	if err != nil {
+		return nil, err
+	}
+	return ReadManifest(sub, network)
+}
+
+// LoadManifestDir reads the manifest of network from a deployments directory
+// laid out as <dir>/<network>/core.json, for networks deployed after the
+// package was built.
+func LoadManifestDir(dir, network string) (*Manifest, error) {
+	return ReadManifest(os.DirFS(dir), network)
+}
+
+// ReadManifest reads <network>/core.json and the optional <network>/earn.json
+// from fsys.
+func ReadManifest(fsys fs.FS, network string) (*Manifest, error) {
+	data, err := fs.ReadFile(fsys, path.Join(network, "core.json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownNetwork, network)
+	} else if err != nil {
+		return nil, err
+	}
+	m := new(Manifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%s core.json: %w", network, err)
+	}
+	if m.Network != network {
+		return nil, fmt.Errorf("%s core.json: network is %q", network, m.Network)
+	}
+
+	data, err = fs.ReadFile(fsys, path.Join(network, "earn.json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		return nil, err
+	}
+	earn := new(EarnManifest)
+	if err := json.Unmarshal(data, earn); err != nil {
+		return nil, fmt.Errorf("%s earn.json: %w", network, err)
+	}
+	if earn.ChainID != m.ChainID {
+		return nil, fmt.Errorf("%s earn.json: %w: chain %d, core.json has %d", network, ErrChainIDMismatch, earn.ChainID, m.ChainID)
+	}
+	for name, addr := range earn.CoreRef {
+		if core, ok := m.Contracts[name]; ok && core != addr {
+			return nil, fmt.Errorf("%s earn.json: coreRef %s is %s, core.json has %s", network, name, addr.Hex(), core.Hex())
+		}
+	}
+	m.Earn = earn
+	return m, nil
+}
+
+// Address returns the address of the contract with the given binding type
+// name, looking through the core contracts, the EARN contracts and the
+// entryPoint field.
+func (m *Manifest) Address(name string) (common.Address, bool) {
+	key := name
+	if alias, ok := manifestAliases[name]; ok {
+		key = alias
+	}
+	if addr, ok := m.Contracts[key]; ok {
+		return addr, true
+	}
+	if m.Earn != nil {
+		if addr, ok := m.Earn.Contracts[key]; ok {
+			return addr, true
+		}
+	}
+	if name == "EntryPoint" && m.EntryPoint != (common.Address{}) {
+		return m.EntryPoint, true
+	}
+	return common.Address{}, false
+}
+
+// LatestConfigUpdate returns the most recent configUpdates entry of the given
+// type, or nil.
+func (m *Manifest) LatestConfigUpdate(typ string) *ConfigUpdate {
+	var latest *ConfigUpdate
+	for i := range m.ConfigUpdates {
+		u := &m.ConfigUpdates[i]
+		if u.Type == typ && (latest == nil || u.BlockNumber > latest.BlockNumber) {
+			latest = u
+		}
+	}
+	return latest
+}
+
+// Production reports whether m is a production deployment, i.e. neither a
+// testnet by name nor marked isLocalTest.
+func (m *Manifest) Production() bool {
+	return !m.IsLocalTest && !strings.Contains(strings.ToLower(m.Network), "testnet")
+}
+
+// ProductionChainIDs returns the chain IDs of the embedded production
+// manifests.
+func ProductionChainIDs() []uint64 {
+	var ids []uint64
+	for _, network := range Networks() {
+		m, err := LoadManifest(network)
+		if err == nil && m.Production() {
+			ids = append(ids, m.ChainID)
+		}
+	}
+	return ids
+}
+
+// ClientSetBackend is a contract backend that reports its chain ID, such as
+// *ethclient.Client or the simulated backend client.
+type ClientSetBackend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// ClientSet holds a binding for every contract of a manifest. Contracts the
+// network has not deployed are nil.
+type ClientSet struct {
+	Manifest *Manifest
+	Backend  ClientSetBackend
+
+	EntryPoint        *EntryPoint
+	CPOPToken         *CPOPToken
+	GasPriceOracle    *GasPriceOracle
+	MasterAggregator  *MasterAggregator
+	SessionKeyManager *SessionKeyManager
+	AccountManager    *AccountManager
+	GasPaymaster      *GasPaymaster
+	CPNFT             *CPNFT
+	StakingConfig     *StakingConfig
+	Staking           *Staking
+	StakingReader     *StakingReader
+	BatchTransfer     *BatchTransfer
+	MockUSDT          *MockUSDT
+	Payment           *Payment
+	Marketplace       *Marketplace
+
+	ChapoolEarnVault         *ChapoolEarnVault
+	VeCPOTLocker             *VeCPOTLocker
+	NFTBoostController       *NFTBoostController
+	ChapoolRewardDistributor *ChapoolRewardDistributor
+	ChapoolVaultReader       *ChapoolVaultReader
+
+	client *ethclient.Client
+}
+
+// NewClientSet checks that backend is connected to the manifest chain and
+// binds every contract of m to it.
+func NewClientSet(ctx context.Context, backend ClientSetBackend, m *Manifest) (*ClientSet, error) {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("chain ID: %w", err)
+	}
+	if !chainID.IsUint64() || chainID.Uint64() != m.ChainID {
+		return nil, fmt.Errorf("%w: %s manifest is for chain %d, backend is on chain %s", ErrChainIDMismatch, m.Network, m.ChainID, chainID)
+	}
+	s := &ClientSet{Manifest: m, Backend: backend}
+	b := &manifestBinder{manifest: m, backend: backend}
+	s.EntryPoint = bindManifest(b, "EntryPoint", NewEntryPoint)
+	s.CPOPToken = bindManifest(b, "CPOPToken", NewCPOPToken)
+	s.GasPriceOracle = bindManifest(b, "GasPriceOracle", NewGasPriceOracle)
+	s.MasterAggregator = bindManifest(b, "MasterAggregator", NewMasterAggregator)
+	s.SessionKeyManager = bindManifest(b, "SessionKeyManager", NewSessionKeyManager)
+	s.AccountManager = bindManifest(b, "AccountManager", NewAccountManager)
+	s.GasPaymaster = bindManifest(b, "GasPaymaster", NewGasPaymaster)
+	s.CPNFT = bindManifest(b, "CPNFT", NewCPNFT)
+	s.StakingConfig = bindManifest(b, "StakingConfig", NewStakingConfig)
+	s.Staking = bindManifest(b, "Staking", NewStaking)
+	s.StakingReader = bindManifest(b, "StakingReader", NewStakingReader)
+	s.BatchTransfer = bindManifest(b, "BatchTransfer", NewBatchTransfer)
+	s.MockUSDT = bindManifest(b, "MockUSDT", NewMockUSDT)
+	s.Payment = bindManifest(b, "Payment", NewPayment)
+	s.Marketplace = bindManifest(b, "Marketplace", NewMarketplace)
+	s.ChapoolEarnVault = bindManifest(b, "ChapoolEarnVault", NewChapoolEarnVault)
+	s.VeCPOTLocker = bindManifest(b, "VeCPOTLocker", NewVeCPOTLocker)
+	s.NFTBoostController = bindManifest(b, "NFTBoostController", NewNFTBoostController)
+	s.ChapoolRewardDistributor = bindManifest(b, "ChapoolRewardDistributor", NewChapoolRewardDistributor)
+	s.ChapoolVaultReader = bindManifest(b, "ChapoolVaultReader", NewChapoolVaultReader)
+	if b.err != nil {
+		return nil, b.err
+	}
+	return s, nil
+}
+
+// manifestBinder binds manifest contracts, keeping the first error.
+type manifestBinder struct {
+	manifest *Manifest
+	backend  bind.ContractBackend
+	err      error
+}
+
+// bindManifest binds the named contract with newContract, returning nil when
+// the manifest has no such contract.
+func bindManifest[T any](b *manifestBinder, name string, newContract func(common.Address, bind.ContractBackend) (*T, error)) *T {
+	addr, ok := b.manifest.Address(name)
+	if !ok || b.err != nil {
+		return nil
+	}
+	contract, err := newContract(addr, b.backend)
+	if err != nil {
+		b.err = fmt.Errorf("%s: %w", name, err)
+	}
+	return contract
+}
+
+// DialClientSet connects to rawurl and binds the contracts of the embedded
+// manifest of network. Close releases the connection.
+func DialClientSet(ctx context.Context, rawurl, network string) (*ClientSet, error) {
+	m, err := LoadManifest(network)
+	if err != nil {
+		return nil, err
+	}
+	client, err := ethclient.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewClientSet(ctx, client, m)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	s.client = client
+	return s, nil
+}
+
+// Address returns the manifest address of the named contract, or the zero
+// address.
+func (s *ClientSet) Address(name string) common.Address {
+	addr, _ := s.Manifest.Address(name)
+	return addr
+}
+
+// Close closes the connection opened by DialClientSet. It does nothing for
+// client sets created with NewClientSet.
+func (s *ClientSet) Close() {
+	if s.client != nil {
+		s.client.Close()
+	}
+}
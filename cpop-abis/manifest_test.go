@@ -0,0 +1,140 @@
+package cpop_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// rootDeployments is the repository deployments directory that
+// generate-bindings.sh copies the embedded manifests from.
+const rootDeployments = "../deployments"
+
+// TestManifestCopies checks that the embedded manifests are up to date with
+// the repository deployments directory.
+func TestManifestCopies(t *testing.T) {
+	if _, err := os.Stat(rootDeployments); err != nil {
+		t.Skipf("no repository deployments directory: %v", err)
+	}
+	networks := cpop.Networks()
+	if want := []string{"bnbTestnet", "opbnb", "opbnbTestnet"}; !slices.Equal(networks, want) {
+		t.Fatalf("embedded networks %v, want %v", networks, want)
+	}
+	for _, network := range networks {
+		for _, name := range []string{"core.json", "earn.json"} {
+			root, rootErr := os.ReadFile(filepath.Join(rootDeployments, network, name))
+			copied, copyErr := os.ReadFile(filepath.Join("deployments", network, name))
+			if errors.Is(rootErr, os.ErrNotExist) && errors.Is(copyErr, os.ErrNotExist) {
+				continue
+			}
+			if rootErr != nil || copyErr != nil {
+				t.Errorf("%s/%s: repository %v, copy %v; run generate-bindings.sh", network, name, rootErr, copyErr)
+				continue
+			}
+			if !bytes.Equal(root, copied) {
+				t.Errorf("%s/%s differs from the repository manifest; run generate-bindings.sh", network, name)
+			}
+		}
+		embedded, err := cpop.LoadManifest(network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir, err := cpop.LoadManifestDir(rootDeployments, network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(embedded, dir) {
+			t.Errorf("%s: embedded manifest differs from the repository one", network)
+		}
+	}
+	if _, err := cpop.LoadManifest("mainnet"); !errors.Is(err, cpop.ErrUnknownNetwork) {
+		t.Errorf("LoadManifest(mainnet) = %v, want ErrUnknownNetwork", err)
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	core := `{"network": "local", "chainId": 1337, "contracts": {"CPPToken": "0x00000000000000000000000000000000000000aa"}}`
+	for _, c := range []struct {
+		name       string
+		core, earn string
+		err        error
+		contains   string
+	}{
+		{name: "core only", core: core},
+		{name: "earn", core: core, earn: `{"chainId": 1337, "contracts": {"ChapoolEarnVault": "0x00000000000000000000000000000000000000bb"}, "coreRef": {"CPPToken": "0x00000000000000000000000000000000000000aa"}}`},
+		{name: "earn on another chain", core: core, earn: `{"chainId": 56}`, err: cpop.ErrChainIDMismatch},
+		{name: "earn wired to other core", core: core, earn: `{"chainId": 1337, "coreRef": {"CPPToken": "0x00000000000000000000000000000000000000cc"}}`, contains: "coreRef CPPToken"},
+		{name: "other network", core: `{"network": "opbnb", "chainId": 1337}`, contains: `network is "opbnb"`},
+		{name: "missing", err: cpop.ErrUnknownNetwork},
+	} {
+		fsys := fstest.MapFS{}
+		if c.core != "" {
+			fsys["local/core.json"] = &fstest.MapFile{Data: []byte(c.core)}
+		}
+		if c.earn != "" {
+			fsys["local/earn.json"] = &fstest.MapFile{Data: []byte(c.earn)}
+		}
+		m, err := cpop.ReadManifest(fsys, "local")
+		switch {
+		case c.err != nil || c.contains != "":
+			if err == nil || (c.err != nil && !errors.Is(err, c.err)) || !strings.Contains(err.Error(), c.contains) {
+				t.Errorf("%s: ReadManifest = %v, want %v %q", c.name, err, c.err, c.contains)
+			}
+		case err != nil:
+			t.Errorf("%s: %v", c.name, err)
+		case (m.Earn != nil) != (c.earn != ""):
+			t.Errorf("%s: earn manifest %+v", c.name, m.Earn)
+		}
+	}
+}
+
+// TestNewClientSetChainID checks that a ClientSet is only bound on the chain
+// of its manifest.
+func TestNewClientSetChainID(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+
+	for _, network := range cpop.Networks() {
+		m, err := cpop.LoadManifest(network)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cpop.NewClientSet(ctx, sim.Client(), m); !errors.Is(err, cpop.ErrChainIDMismatch) {
+			t.Errorf("%s on the simulated chain: %v, want ErrChainIDMismatch", network, err)
+		}
+	}
+
+	m, err := cpop.LoadManifest("opbnbTestnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.ChainID = cpoptest.ChainID.Uint64()
+	s, err := cpop.NewClientSet(ctx, sim.Client(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.CPOPToken == nil || s.Marketplace == nil || s.ChapoolEarnVault == nil || s.EntryPoint == nil {
+		t.Errorf("client set missing manifest contracts: %+v", s)
+	}
+	if s.Address("CPOPToken") != m.Contracts["CPPToken"] || s.Address("ChapoolEarnVault") != m.Earn.Contracts["ChapoolEarnVault"] {
+		t.Errorf("addresses %s, %s", s.Address("CPOPToken").Hex(), s.Address("ChapoolEarnVault").Hex())
+	}
+	if s.Address("Unknown") != (common.Address{}) {
+		t.Errorf("unknown contract at %s", s.Address("Unknown").Hex())
+	}
+}
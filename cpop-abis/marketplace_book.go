@@ -0,0 +1,436 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrListingNotFound is returned for listing IDs the order book or the
+	// Marketplace does not know.
+	ErrListingNotFound = errors.New("listing does not exist")
+	// ErrListingNotActive is returned for listings that are sold or cancelled.
+	ErrListingNotActive = errors.New("listing is not active")
+	// ErrNotAuction is returned when bidding on a fixed price listing.
+	ErrNotAuction = errors.New("not an auction listing")
+	// ErrAuctionEnded is returned when bidding at or after the auction end time.
+	ErrAuctionEnded = errors.New("auction has ended")
+	// ErrBidTooLow is returned for bids below MarketListing.MinNextBid.
+	ErrBidTooLow = errors.New("bid amount too low")
+	// ErrSellerBid is returned when the seller bids on their own auction.
+	ErrSellerBid = errors.New("seller cannot bid on own auction")
+)
+
+// ListingType mirrors IMarketplace.ListingType.
+type ListingType uint8
+
+const (
+	// ListingFixedPrice is sold to the first buyer at the listing price.
+	ListingFixedPrice ListingType = iota
+	// ListingAuction is sold to the highest bidder once the auction ends.
+	ListingAuction
+)
+
+func (t ListingType) String() string {
+	switch t {
+	case ListingFixedPrice:
+		return "fixed-price"
+	case ListingAuction:
+		return "auction"
+	default:
+		return fmt.Sprintf("ListingType(%d)", int(t))
+	}
+}
+
+// ListingStatus mirrors IMarketplace.ListingStatus.
+type ListingStatus uint8
+
+const (
+	// ListingActive is open for purchase or bids.
+	ListingActive ListingStatus = iota
+	// ListingCancelled was withdrawn and its NFT returned to the seller.
+	ListingCancelled
+	// ListingSold was bought or settled.
+	ListingSold
+)
+
+func (s ListingStatus) String() string {
+	switch s {
+	case ListingActive:
+		return "active"
+	case ListingCancelled:
+		return "cancelled"
+	case ListingSold:
+		return "sold"
+	default:
+		return fmt.Sprintf("ListingStatus(%d)", int(s))
+	}
+}
+
+// MarketBid is an auction bid.
+type MarketBid struct {
+	Bidder    common.Address
+	Payer     common.Address
+	Amount    *big.Int
+	Timestamp uint64 // zero when rebuilt from events
+	Refunded  bool   // also set on the winning bid once settled
+}
+
+// MarketListing is a Marketplace listing with its bids and sale.
+type MarketListing struct {
+	ID              *big.Int
+	Seller          common.Address
+	NFTContract     common.Address
+	TokenID         *big.Int
+	Type            ListingType
+	Price           *big.Int // fixed price or starting bid
+	Status          ListingStatus
+	StartTime       uint64   // zero when rebuilt from events
+	EndTime         uint64   // zero for fixed price listings
+	MinBidIncrement *big.Int // basis points, nil when rebuilt from events
+
+	Bids       []MarketBid // in placement order
+	HighestBid int         // index into Bids, -1 without bids
+
+	// Set once sold.
+	Buyer       common.Address
+	Payer       common.Address
+	FinalPrice  *big.Int
+	PlatformFee *big.Int
+}
+
+// NewMarketListing converts the Marketplace getListing and getBids results.
+func NewMarketListing(l IMarketplaceListing, bids []IMarketplaceBid, highestBid int) *MarketListing {
+	m := &MarketListing{
+		ID:              l.ListingId,
+		Seller:          l.Seller,
+		NFTContract:     l.NftContract,
+		TokenID:         l.TokenId,
+		Type:            ListingType(l.ListingType),
+		Price:           l.Price,
+		Status:          ListingStatus(l.Status),
+		StartTime:       bigOrZero(l.StartTime).Uint64(),
+		EndTime:         bigOrZero(l.EndTime).Uint64(),
+		MinBidIncrement: l.MinBidIncrement,
+		HighestBid:      -1,
+	}
+	for _, b := range bids {
+		m.Bids = append(m.Bids, MarketBid{Bidder: b.Bidder, Payer: b.Payer, Amount: b.Amount, Timestamp: bigOrZero(b.Timestamp).Uint64(), Refunded: b.Refunded})
+	}
+	if highestBid < len(m.Bids) {
+		m.HighestBid = highestBid
+	}
+	return m
+}
+
+// Highest returns the current highest bid, or nil.
+func (l *MarketListing) Highest() *MarketBid {
+	if l.HighestBid < 0 || l.HighestBid >= len(l.Bids) {
+		return nil
+	}
+	return &l.Bids[l.HighestBid]
+}
+
+// MinNextBid mirrors the minimum bid of Marketplace.placeBid: the starting
+// price for the first bid, then the highest bid raised by MinBidIncrement
+// basis points.
+func (l *MarketListing) MinNextBid() *big.Int {
+	highest := l.Highest()
+	if highest == nil {
+		return new(big.Int).Set(l.Price)
+	}
+	increment := new(big.Int).Mul(highest.Amount, bigOrZero(l.MinBidIncrement))
+	increment.Quo(increment, big.NewInt(basisPoints))
+	return increment.Add(increment, highest.Amount)
+}
+
+// ValidateBid checks a bid of amount by bidder at timestamp now against the
+// Marketplace.placeBid requirements.
+func (l *MarketListing) ValidateBid(bidder common.Address, amount *big.Int, now uint64) error {
+	switch {
+	case l.Status != ListingActive:
+		return fmt.Errorf("%w: listing %s is %s", ErrListingNotActive, l.ID, l.Status)
+	case l.Type != ListingAuction:
+		return fmt.Errorf("%w: listing %s", ErrNotAuction, l.ID)
+	case bidder == l.Seller:
+		return ErrSellerBid
+	case now >= l.EndTime:
+		return fmt.Errorf("%w: listing %s ended at %d", ErrAuctionEnded, l.ID, l.EndTime)
+	}
+	if l.MinBidIncrement == nil && l.Highest() != nil {
+		return fmt.Errorf("listing %s: min bid increment unknown, load the listing first", l.ID)
+	}
+	if min := l.MinNextBid(); amount.Cmp(min) < 0 {
+		return fmt.Errorf("%w: %s < %s", ErrBidTooLow, amount, min)
+	}
+	return nil
+}
+
+// SaleProceeds mirrors the fee split of Marketplace.buyItem and settleAuction:
+// the platform fee is price * feeRate / 10000 and the seller receives the rest.
+func SaleProceeds(price *big.Int, feeRate uint64) (seller, fee *big.Int) {
+	fee = mulBps(new(big.Int).Set(price), feeRate)
+	return new(big.Int).Sub(price, fee), fee
+}
+
+// OrderBook is a live view of the Marketplace listings, rebuilt from its
+// ListingCreated, BidPlaced, BidRefunded, ItemSold, AuctionSettled and
+// ListingCancelled events. Events removed by a reorg are taken out and the
+// book is replayed without them.
+type OrderBook struct {
+	base     map[string]*MarketListing // listings loaded from chain
+	events   []any                     // applied events in order
+	listings map[string]*MarketListing
+}
+
+// NewOrderBook returns an empty order book.
+func NewOrderBook() *OrderBook {
+	return &OrderBook{base: make(map[string]*MarketListing), listings: make(map[string]*MarketListing)}
+}
+
+// Put adds a listing read from chain, e.g. to start from a snapshot instead of
+// the deployment block. Only events after the snapshot should be applied on
+// top of it; its ListingCreated event is ignored.
+func (b *OrderBook) Put(l *MarketListing) {
+	b.base[l.ID.String()] = l
+	b.replay()
+}
+
+// Listing returns the listing with the given ID, or nil.
+func (b *OrderBook) Listing(id *big.Int) *MarketListing {
+	return b.listings[id.String()]
+}
+
+// Listings returns the listings matching filter, or all of them if filter is
+// nil, ordered by ID.
+func (b *OrderBook) Listings(filter func(*MarketListing) bool) []*MarketListing {
+	var out []*MarketListing
+	for _, l := range b.listings {
+		if filter == nil || filter(l) {
+			out = append(out, l)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Cmp(out[j].ID) < 0 })
+	return out
+}
+
+// Active returns the active listings ordered by ID.
+func (b *OrderBook) Active() []*MarketListing {
+	return b.Listings(func(l *MarketListing) bool { return l.Status == ListingActive })
+}
+
+// Apply applies a Marketplace event binding, such as *MarketplaceBidPlaced
+// or the Event of an IndexedEvent. Other events are ignored.
+func (b *OrderBook) Apply(event any) error {
+	raw := marketEventLog(event)
+	if raw == nil {
+		return nil
+	}
+	if raw.Removed {
+		for i := len(b.events) - 1; i >= 0; i-- {
+			if l := marketEventLog(b.events[i]); l.TxHash == raw.TxHash && l.Index == raw.Index {
+				b.events = append(b.events[:i], b.events[i+1:]...)
+				return b.replay()
+			}
+		}
+		return nil
+	}
+	if err := b.apply(event); err != nil {
+		return err
+	}
+	b.events = append(b.events, event)
+	return nil
+}
+
+// replay rebuilds the listings from the loaded ones and the applied events.
+func (b *OrderBook) replay() error {
+	b.listings = make(map[string]*MarketListing, len(b.base))
+	for key, l := range b.base {
+		copied := *l
+		copied.Bids = append([]MarketBid(nil), l.Bids...)
+		b.listings[key] = &copied
+	}
+	var err error
+	for _, event := range b.events {
+		if e := b.apply(event); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// apply updates the listings with one event.
+func (b *OrderBook) apply(event any) error {
+	switch ev := event.(type) {
+	case *MarketplaceListingCreated:
+		if b.listings[ev.ListingId.String()] != nil {
+			return nil // loaded with Put
+		}
+		b.listings[ev.ListingId.String()] = &MarketListing{
+			ID:          ev.ListingId,
+			Seller:      ev.Seller,
+			NFTContract: ev.NftContract,
+			TokenID:     ev.TokenId,
+			Type:        ListingType(ev.ListingType),
+			Price:       ev.Price,
+			Status:      ListingActive,
+			EndTime:     bigOrZero(ev.EndTime).Uint64(),
+			HighestBid:  -1,
+		}
+		return nil
+	case *MarketplaceBidPlaced:
+		l, err := b.listing(ev.ListingId)
+		if err != nil {
+			return err
+		}
+		if highest := l.Highest(); highest != nil {
+			highest.Refunded = true
+		}
+		l.Bids = append(l.Bids, MarketBid{Bidder: ev.Bidder, Payer: ev.Payer, Amount: ev.Amount})
+		l.HighestBid = len(l.Bids) - 1
+	case *MarketplaceBidRefunded:
+		l, err := b.listing(ev.ListingId)
+		if err != nil {
+			return err
+		}
+		for i := range l.Bids {
+			bid := &l.Bids[i]
+			if !bid.Refunded && bid.Bidder == ev.Bidder && bid.Payer == ev.Payer && bid.Amount.Cmp(ev.Amount) == 0 {
+				bid.Refunded = true
+				break
+			}
+		}
+	case *MarketplaceItemSold:
+		l, err := b.listing(ev.ListingId)
+		if err != nil {
+			return err
+		}
+		l.Status, l.Buyer, l.Payer, l.FinalPrice, l.PlatformFee = ListingSold, ev.Buyer, ev.Payer, ev.Price, ev.PlatformFee
+	case *MarketplaceAuctionSettled:
+		l, err := b.listing(ev.ListingId)
+		if err != nil {
+			return err
+		}
+		l.Status, l.Buyer, l.Payer, l.FinalPrice, l.PlatformFee = ListingSold, ev.Winner, ev.Payer, ev.FinalPrice, ev.PlatformFee
+		if highest := l.Highest(); highest != nil {
+			highest.Refunded = true
+		}
+	case *MarketplaceListingCancelled:
+		l, err := b.listing(ev.ListingId)
+		if err != nil {
+			return err
+		}
+		l.Status = ListingCancelled
+		for i := range l.Bids {
+			l.Bids[i].Refunded = true
+		}
+	}
+	return nil
+}
+
+// listing returns the listing an event refers to.
+func (b *OrderBook) listing(id *big.Int) (*MarketListing, error) {
+	if l := b.listings[id.String()]; l != nil {
+		return l, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrListingNotFound, id)
+}
+
+// marketEventLog returns the raw log of a Marketplace order book event, or nil
+// for other values.
+func marketEventLog(event any) *types.Log {
+	switch ev := event.(type) {
+	case *MarketplaceListingCreated:
+		return &ev.Raw
+	case *MarketplaceBidPlaced:
+		return &ev.Raw
+	case *MarketplaceBidRefunded:
+		return &ev.Raw
+	case *MarketplaceItemSold:
+		return &ev.Raw
+	case *MarketplaceAuctionSettled:
+		return &ev.Raw
+	case *MarketplaceListingCancelled:
+		return &ev.Raw
+	}
+	return nil
+}
+
+// MarketplaceClient validates Marketplace calls against the current chain
+// state before sending them.
+type MarketplaceClient struct {
+	Contract *Marketplace
+}
+
+// NewMarketplaceClient binds the Marketplace at address.
+func NewMarketplaceClient(address common.Address, backend bind.ContractBackend) (*MarketplaceClient, error) {
+	contract, err := NewMarketplace(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MarketplaceClient{Contract: contract}, nil
+}
+
+// Listing reads a listing with its bids.
+func (c *MarketplaceClient) Listing(opts *bind.CallOpts, id *big.Int) (*MarketListing, error) {
+	l, err := c.Contract.GetListing(opts, id)
+	if err != nil {
+		if revert, ok := AsRevertError(err).(*RevertError); ok && revert.Reason == "Listing does not exist" {
+			return nil, fmt.Errorf("%w: %s", ErrListingNotFound, id)
+		}
+		return nil, fmt.Errorf("listing %s: %w", id, AsRevertError(err))
+	}
+	bids, err := c.Contract.GetBids(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("bids of listing %s: %w", id, AsRevertError(err))
+	}
+	highest, err := c.Contract.HighestBidIndex(opts, id)
+	if err != nil {
+		return nil, fmt.Errorf("highest bid of listing %s: %w", id, err)
+	}
+	idx := -1
+	if len(bids) > 0 && highest.IsInt64() {
+		idx = int(highest.Int64())
+	}
+	return NewMarketListing(l, bids, idx), nil
+}
+
+// ValidateBid checks a bid against the listing and the Marketplace clock,
+// which follows the test timestamp when test mode is enabled.
+func (c *MarketplaceClient) ValidateBid(opts *bind.CallOpts, id *big.Int, bidder common.Address, amount *big.Int) (*MarketListing, error) {
+	l, err := c.Listing(opts, id)
+	if err != nil {
+		return nil, err
+	}
+	now, err := c.Contract.GetCurrentTimestamp(opts)
+	if err != nil {
+		return nil, fmt.Errorf("current timestamp: %w", err)
+	}
+	return l, l.ValidateBid(bidder, amount, now.Uint64())
+}
+
+// PlaceBid validates the bid and sends Marketplace.placeBid with auth, which
+// must be the Marketplace owner.
+func (c *MarketplaceClient) PlaceBid(auth *bind.TransactOpts, id *big.Int, bidder, payer common.Address, amount *big.Int) (*types.Transaction, error) {
+	if _, err := c.ValidateBid(&bind.CallOpts{Context: auth.Context}, id, bidder, amount); err != nil {
+		return nil, err
+	}
+	return c.Contract.PlaceBid(auth, id, bidder, payer, amount)
+}
+
+// Proceeds returns the seller amount and platform fee of a sale at price
+// under the current platform fee rate.
+func (c *MarketplaceClient) Proceeds(opts *bind.CallOpts, price *big.Int) (seller, fee *big.Int, err error) {
+	rate, err := c.Contract.PlatformFeeRate(opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("platform fee rate: %w", err)
+	}
+	seller, fee = SaleProceeds(price, rate.Uint64())
+	return seller, fee, nil
+}
@@ -0,0 +1,261 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// removedMarketEvent returns a copy of a Marketplace order book event as
+// delivered again after a reorg. Other events are returned as is.
+func removedMarketEvent(event any) any {
+	switch ev := event.(type) {
+	case *cpop.MarketplaceListingCreated:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.MarketplaceBidPlaced:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.MarketplaceBidRefunded:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.MarketplaceItemSold:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.MarketplaceAuctionSettled:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.MarketplaceListingCancelled:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	}
+	return event
+}
+
+func TestOrderBook(t *testing.T) {
+	ctx := context.Background()
+	tokenKey, _ := crypto.GenerateKey()  // owns CPNFT and MockUSDT
+	marketKey, _ := crypto.GenerateKey() // owns Marketplace and receives its fees
+	aliceKey, _ := crypto.GenerateKey()
+	bobKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(tokenKey, marketKey, aliceKey, bobKey)
+	defer sim.Close()
+	tokenOwner, owner := cpoptest.NewTransactor(tokenKey), cpoptest.NewTransactor(marketKey)
+	alice, bob := cpoptest.NewTransactor(aliceKey), cpoptest.NewTransactor(bobKey)
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := cpoptest.DeployMarket(sim, tokenOwner, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	market, usdt, marketAddr := m.Marketplace, m.MockUSDT, m.Addresses.Marketplace
+	for range 4 {
+		mine(m.CPNFT.Mint(tokenOwner, seller, levelC))
+	}
+	for _, b := range []*bind.TransactOpts{alice, bob} {
+		mine(usdt.Mint(tokenOwner, b.From, big.NewInt(1e9)))
+		mine(usdt.Approve(b, marketAddr, big.NewInt(1e9)))
+	}
+	clock, err := cpoptest.NewTestClock(ctx, sim.Client(), owner, &cpoptest.TestClockOptions{Simulated: sim}, market)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &cpop.MarketplaceClient{Contract: market}
+	raw := &cpop.MarketplaceRaw{Contract: market}
+
+	manifest := &cpop.Manifest{Network: "simulated", Contracts: map[string]common.Address{"Marketplace": marketAddr}}
+	ix, err := cpop.NewIndexer(sim.Client(), manifest, cpop.NewMemoryIndexerStore(), cpop.IndexerConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	book := cpop.NewOrderBook()
+	var applied []any
+	sync := func() {
+		t.Helper()
+		if _, err := ix.Sync(ctx, func(ev *cpop.IndexedEvent) error {
+			applied = append(applied, ev.Event)
+			return book.Apply(ev.Event)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// check compares the book with the listings and bids of the contract.
+	check := func(opts *bind.CallOpts) {
+		t.Helper()
+		for id := int64(1); id <= 4; id++ {
+			got := book.Listing(big.NewInt(id))
+			want, err := client.Listing(opts, big.NewInt(id))
+			if errors.Is(err, cpop.ErrListingNotFound) {
+				if got != nil {
+					t.Errorf("listing %d in the book but not on chain", id)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got == nil {
+				t.Fatalf("listing %d missing from the book", id)
+			}
+			if got.Seller != want.Seller || got.TokenID.Cmp(want.TokenID) != 0 || got.Type != want.Type || got.Price.Cmp(want.Price) != 0 ||
+				got.Status != want.Status || got.EndTime != want.EndTime || got.HighestBid != want.HighestBid || len(got.Bids) != len(want.Bids) {
+				t.Fatalf("listing %d = %+v, want %+v", id, got, want)
+			}
+			for i, b := range want.Bids {
+				g := got.Bids[i]
+				if g.Bidder != b.Bidder || g.Payer != b.Payer || g.Amount.Cmp(b.Amount) != 0 || g.Refunded != b.Refunded {
+					t.Errorf("listing %d bid %d = %+v, want %+v", id, i, g, b)
+				}
+			}
+		}
+	}
+	// bid places the minimum next bid on an auction. One wei less is turned
+	// down both by ValidateBid and by the contract.
+	bid := func(id int64, bidder *bind.TransactOpts) *big.Int {
+		t.Helper()
+		l, err := client.Listing(nil, big.NewInt(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		min := l.MinNextBid()
+		var out []interface{}
+		low := new(big.Int).Sub(min, big.NewInt(1))
+		if _, err := client.ValidateBid(nil, big.NewInt(id), bidder.From, low); !errors.Is(err, cpop.ErrBidTooLow) {
+			t.Fatalf("listing %d: ValidateBid(%s) = %v, want ErrBidTooLow", id, low, err)
+		}
+		err = raw.Call(&bind.CallOpts{From: owner.From}, &out, "placeBid", big.NewInt(id), bidder.From, bidder.From, low)
+		if revert, ok := cpop.DecodeRevert(err); !ok || (revert.Reason != "Bid amount too low" && revert.Reason != "Bid must be at least starting price") {
+			t.Fatalf("listing %d: placeBid(%s) = %v, want a too low revert", id, low, err)
+		}
+		if _, err := client.ValidateBid(nil, big.NewInt(id), bidder.From, min); err != nil {
+			t.Fatalf("listing %d: ValidateBid(%s) = %v", id, min, err)
+		}
+		mine(client.PlaceBid(owner, big.NewInt(id), bidder.From, bidder.From, min))
+		return min
+	}
+	balance := func(addr common.Address) *big.Int {
+		t.Helper()
+		b, err := usdt.BalanceOf(nil, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	// sold checks the payments of a sale at price against SaleProceeds.
+	sold := func(id int64, price, sellerBefore, feeBefore *big.Int) {
+		t.Helper()
+		sellerAmount, fee, err := client.Proceeds(nil, price)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotSeller := new(big.Int).Sub(balance(seller), sellerBefore)
+		gotFee := new(big.Int).Sub(balance(owner.From), feeBefore)
+		if gotSeller.Cmp(sellerAmount) != 0 || gotFee.Cmp(fee) != 0 {
+			t.Errorf("listing %d sold at %s: seller got %s, fee %s; SaleProceeds %s, %s", id, price, gotSeller, gotFee, sellerAmount, fee)
+		}
+		if l := book.Listing(big.NewInt(id)); l.FinalPrice.Cmp(price) != 0 || l.PlatformFee.Cmp(fee) != 0 {
+			t.Errorf("listing %d in the book sold at %s with fee %s, want %s and %s", id, l.FinalPrice, l.PlatformFee, price, fee)
+		}
+	}
+
+	// Fixed price listings 1 and 4, auctions 2 and 3 with increments that
+	// round down.
+	mine(market.CreateListing(owner, seller, big.NewInt(1), uint8(cpop.ListingFixedPrice), big.NewInt(300), big.NewInt(0), big.NewInt(0)))
+	mine(market.CreateListing(owner, seller, big.NewInt(2), uint8(cpop.ListingAuction), big.NewInt(100), big.NewInt(3600), big.NewInt(333)))
+	mine(market.CreateListing(owner, seller, big.NewInt(3), uint8(cpop.ListingAuction), big.NewInt(50), big.NewInt(3600), big.NewInt(1)))
+	mine(market.CreateListing(owner, seller, big.NewInt(4), uint8(cpop.ListingFixedPrice), big.NewInt(1001), big.NewInt(0), big.NewInt(0)))
+	// Each outbid is refunded in a BidRefunded logged before its BidPlaced.
+	for i, want := range []int64{100, 103, 106} {
+		if got := bid(2, []*bind.TransactOpts{alice, bob}[i%2]); got.Int64() != want {
+			t.Fatalf("bid %d on listing 2 = %s, want %d", i, got, want)
+		}
+	}
+	// 1 bps of 50 rounds to nothing, so an equal bid outbids.
+	bid(3, alice)
+	if got := bid(3, bob); got.Int64() != 50 {
+		t.Fatalf("second bid on listing 3 = %s, want 50", got)
+	}
+	sync()
+	check(nil)
+	before, err := sim.Client().HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed := len(applied)
+
+	bid(2, bob) // 109
+	sellerBefore, feeBefore := balance(seller), balance(owner.From)
+	mine(market.BuyItem(owner, big.NewInt(4), bob.From, bob.From))
+	sync()
+	sold(4, big.NewInt(1001), sellerBefore, feeBefore)
+	mine(market.CancelListing(owner, big.NewInt(1)))
+	mine(market.CancelListing(owner, big.NewInt(3)))
+	if err := clock.Advance(ctx, 2*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	sellerBefore, feeBefore = balance(seller), balance(owner.From)
+	mine(market.SettleAuction(owner, big.NewInt(2), bob.From))
+	sync()
+	sold(2, big.NewInt(109), sellerBefore, feeBefore)
+	check(nil)
+	if active := book.Active(); len(active) != 0 {
+		t.Errorf("active listings %v", active)
+	}
+
+	// Taking out the events since before, newest first, gives the book of
+	// that block; applying them again gives the current one.
+	later := applied[replayed:]
+	for i := len(later) - 1; i >= 0; i-- {
+		if err := book.Apply(removedMarketEvent(later[i])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check(&bind.CallOpts{BlockNumber: before.Number})
+	for _, ev := range later {
+		if err := book.Apply(ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check(nil)
+}
+
+func TestSaleProceeds(t *testing.T) {
+	for _, c := range []struct {
+		price, rate, seller, fee int64
+	}{
+		{10_000, 250, 9_750, 250},
+		{1001, 250, 976, 25},  // 25.025 rounds down
+		{39, 250, 39, 0},      // fee below one unit
+		{100, 0, 100, 0},      // no fee
+		{100, 10_000, 0, 100}, // 100% fee
+	} {
+		seller, fee := cpop.SaleProceeds(big.NewInt(c.price), uint64(c.rate))
+		if seller.Int64() != c.seller || fee.Int64() != c.fee {
+			t.Errorf("SaleProceeds(%d, %d) = %s, %s; want %d, %d", c.price, c.rate, seller, fee, c.seller, c.fee)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package cpop
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrEmptyBatch is returned when a batch has no user operations.
+	ErrEmptyBatch = errors.New("empty operations")
+	// ErrTooManyOps is returned when a batch exceeds maxAggregatedOps.
+	ErrTooManyOps = errors.New("too many operations")
+	// ErrUnauthorizedMaster is returned when the signer is not an authorized master.
+	ErrUnauthorizedMaster = errors.New("unauthorized master")
+	// ErrAccountNotControlled is returned when a sender is not controlled by the master.
+	ErrAccountNotControlled = errors.New("account not controlled by master")
+)
+
+var (
+	masterOpArgs = mustArguments(
+		"address", "uint256", "bytes", "bytes32", "uint256", "bytes32", "bytes",
+	)
+	masterAggregationArgs = mustArguments(
+		"string", "address", "uint256", "uint256", "address", "bytes32[]",
+	)
+	masterSignatureArgs = mustArguments("address", "uint256", "bytes")
+)
+
+// mustArguments builds unnamed ABI arguments from type names.
+func mustArguments(types ...string) abi.Arguments {
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return args
+}
+
+// MasterAggregatedHash mirrors MasterAggregator._createAggregatedHash for ops
+// signed by masterSigner with nonce, for the aggregator at aggregator on chainID.
+func MasterAggregatedHash(ops []PackedUserOperation, masterSigner common.Address, nonce *big.Int, chainID *big.Int, aggregator common.Address) (common.Hash, error) {
+	opHashes := make([][32]byte, len(ops))
+	for i, op := range ops {
+		enc, err := masterOpArgs.Pack(
+			op.Sender, bigOrZero(op.Nonce), bytesOrEmpty(op.CallData), op.AccountGasLimits,
+			bigOrZero(op.PreVerificationGas), op.GasFees, bytesOrEmpty(op.PaymasterAndData),
+		)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("encode op %d: %w", i, err)
+		}
+		opHashes[i] = crypto.Keccak256Hash(enc)
+	}
+	enc, err := masterAggregationArgs.Pack("MASTER_AGGREGATION", masterSigner, bigOrZero(nonce), chainID, aggregator, opHashes)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(enc), nil
+}
+
+// MasterSigningHash mirrors MasterAggregator.getMasterSigningData: the
+// eth-signed-message hash of the aggregated hash.
+func MasterSigningHash(ops []PackedUserOperation, masterSigner common.Address, nonce *big.Int, chainID *big.Int, aggregator common.Address) (common.Hash, error) {
+	aggregated, err := MasterAggregatedHash(ops, masterSigner, nonce, chainID, aggregator)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(accounts.TextHash(aggregated.Bytes())), nil
+}
+
+// EncodeMasterAggregatedSignature returns abi.encode(masterSigner, nonce,
+// masterSignature), the aggregated signature MasterAggregator.validateSignatures decodes.
+func EncodeMasterAggregatedSignature(masterSigner common.Address, nonce *big.Int, masterSignature []byte) ([]byte, error) {
+	return masterSignatureArgs.Pack(masterSigner, bigOrZero(nonce), masterSignature)
+}
+
+// DecodeMasterAggregatedSignature splits an aggregated signature into its parts.
+func DecodeMasterAggregatedSignature(signature []byte) (masterSigner common.Address, nonce *big.Int, masterSignature []byte, err error) {
+	values, err := masterSignatureArgs.Unpack(signature)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return values[0].(common.Address), values[1].(*big.Int), values[2].([]byte), nil
+}
+
+// MasterBatch is a batch of user operations signed by a master signer.
+type MasterBatch struct {
+	Aggregator     common.Address
+	Master         common.Address
+	Nonce          *big.Int
+	AggregatedHash common.Hash
+	UserOps        []PackedUserOperation // Signatures cleared for the aggregator path
+	Signature      []byte                // abi.encode(master, nonce, masterSignature)
+}
+
+// UserOpsPerAggregator returns the batch as an EntryPoint.handleAggregatedOps entry.
+func (b *MasterBatch) UserOpsPerAggregator() IEntryPointUserOpsPerAggregator {
+	return IEntryPointUserOpsPerAggregator{
+		UserOps:    b.UserOps,
+		Aggregator: b.Aggregator,
+		Signature:  b.Signature,
+	}
+}
+
+// PackHandleAggregatedOps returns the EntryPoint.handleAggregatedOps calldata
+// submitting batches with beneficiary receiving the gas refund.
+func PackHandleAggregatedOps(beneficiary common.Address, batches ...*MasterBatch) ([]byte, error) {
+	parsed, err := EntryPointMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]IEntryPointUserOpsPerAggregator, len(batches))
+	for i, b := range batches {
+		entries[i] = b.UserOpsPerAggregator()
+	}
+	return parsed.Pack("handleAggregatedOps", entries, beneficiary)
+}
+
+// MasterBatchSigner signs MasterAggregator batches locally. It reads the
+// master nonce and maxAggregatedOps once and then tracks the nonce itself, so
+// signing a batch needs no RPC round-trip once the sender accounts are known.
+type MasterBatchSigner struct {
+	key        *ecdsa.PrivateKey
+	master     common.Address
+	aggregator common.Address
+	chainID    *big.Int
+	caller     *MasterAggregatorCaller
+
+	mu         sync.Mutex
+	nonce      *big.Int
+	maxOps     uint64
+	controlled map[common.Address]bool
+}
+
+// NewMasterBatchSigner creates a batch signer for key on the MasterAggregator
+// at aggregator. It fails with ErrUnauthorizedMaster if key is not an
+// authorized master.
+func NewMasterBatchSigner(opts *bind.CallOpts, backend bind.ContractCaller, aggregator common.Address, chainID *big.Int, key *ecdsa.PrivateKey) (*MasterBatchSigner, error) {
+	if chainID == nil {
+		return nil, errors.New("chain ID required")
+	}
+	caller, err := NewMasterAggregatorCaller(aggregator, backend)
+	if err != nil {
+		return nil, err
+	}
+	s := &MasterBatchSigner{
+		key:        key,
+		master:     crypto.PubkeyToAddress(key.PublicKey),
+		aggregator: aggregator,
+		chainID:    chainID,
+		caller:     caller,
+		controlled: make(map[common.Address]bool),
+	}
+	authorized, err := caller.AuthorizedMasters(opts, s.master)
+	if err != nil {
+		return nil, fmt.Errorf("authorized masters: %w", err)
+	}
+	if !authorized {
+		return nil, fmt.Errorf("%w: %s", ErrUnauthorizedMaster, s.master.Hex())
+	}
+	if err := s.Sync(opts); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Master returns the master signer address.
+func (s *MasterBatchSigner) Master() common.Address { return s.master }
+
+// Nonce returns the nonce the next batch is signed with.
+func (s *MasterBatchSigner) Nonce() *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return new(big.Int).Set(s.nonce)
+}
+
+// MaxOps returns the batch size limit.
+func (s *MasterBatchSigner) MaxOps() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maxOps
+}
+
+// Sync reloads the master nonce and maxAggregatedOps from the aggregator.
+// Call it after a signed batch failed to land, since the local nonce has
+// already moved past it.
+func (s *MasterBatchSigner) Sync(opts *bind.CallOpts) error {
+	nonce, err := s.caller.MasterNonces(opts, s.master)
+	if err != nil {
+		return fmt.Errorf("master nonce: %w", err)
+	}
+	maxOps, err := s.caller.MaxAggregatedOps(opts)
+	if err != nil {
+		return fmt.Errorf("max aggregated ops: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nonce = nonce
+	s.maxOps = maxOps.Uint64()
+	return nil
+}
+
+// AddAccounts records accounts known to be controlled by the master, for
+// example wallets created by the custody service with it as master signer,
+// so they are not checked on chain.
+func (s *MasterBatchSigner) AddAccounts(accounts ...common.Address) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range accounts {
+		s.controlled[a] = true
+	}
+}
+
+// isControlled mirrors MasterAggregator.isAccountControlledByMaster, caching
+// positive answers.
+func (s *MasterBatchSigner) isControlled(opts *bind.CallOpts, account common.Address) (bool, error) {
+	s.mu.Lock()
+	known := s.controlled[account]
+	s.mu.Unlock()
+	if known {
+		return true, nil
+	}
+	ok, err := s.caller.IsAccountControlledByMaster(opts, account, s.master)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		s.AddAccounts(account)
+	}
+	return ok, nil
+}
+
+// SignBatch checks ops against maxAggregatedOps and the account-controlled-by
+// -master rule, signs them with the next nonce and returns the batch ready
+// for EntryPoint.handleAggregatedOps. The nonce is consumed even if the batch
+// is never submitted; use Sync to recover.
+func (s *MasterBatchSigner) SignBatch(opts *bind.CallOpts, ops []PackedUserOperation) (*MasterBatch, error) {
+	if len(ops) == 0 {
+		return nil, ErrEmptyBatch
+	}
+	if limit := s.MaxOps(); uint64(len(ops)) > limit {
+		return nil, fmt.Errorf("%w: %d > %d", ErrTooManyOps, len(ops), limit)
+	}
+	for i, op := range ops {
+		ok, err := s.isControlled(opts, op.Sender)
+		if err != nil {
+			return nil, fmt.Errorf("op %d: %w", i, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("op %d: %w: %s", i, ErrAccountNotControlled, op.Sender.Hex())
+		}
+	}
+
+	batch := &MasterBatch{
+		Aggregator: s.aggregator,
+		Master:     s.master,
+		UserOps:    make([]PackedUserOperation, len(ops)),
+	}
+	for i, op := range ops {
+		// AAccount only defers to the aggregator when the signature is empty.
+		op.Signature = []byte{}
+		op.Nonce, op.PreVerificationGas = bigOrZero(op.Nonce), bigOrZero(op.PreVerificationGas)
+		op.InitCode, op.CallData, op.PaymasterAndData = bytesOrEmpty(op.InitCode), bytesOrEmpty(op.CallData), bytesOrEmpty(op.PaymasterAndData)
+		batch.UserOps[i] = op
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	batch.Nonce = new(big.Int).Set(s.nonce)
+	hash, err := MasterAggregatedHash(batch.UserOps, s.master, batch.Nonce, s.chainID, s.aggregator)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := SignUserOpHash(s.key, hash)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Signature, err = EncodeMasterAggregatedSignature(s.master, batch.Nonce, sig); err != nil {
+		return nil, err
+	}
+	batch.AggregatedHash = hash
+	s.nonce.Add(s.nonce, big.NewInt(1))
+	return batch, nil
+}
+
+// bigOrZero returns v, or zero when v is nil.
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// bytesOrEmpty returns b, or an empty slice when b is nil.
+func bytesOrEmpty(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
@@ -0,0 +1,150 @@
+package cpop_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestMasterAggregatedHash(t *testing.T) {
+	ops := []cpop.PackedUserOperation{testUserOp(t)}
+	master := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	aggregator := common.HexToAddress("0x00000000000000000000000000000000000000ee")
+	aggregated, err := cpop.MasterAggregatedHash(ops, master, big.NewInt(7), big.NewInt(56), aggregator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := common.HexToHash("0x6e8d564b5d9f983cb78493e2fc5f84420d31fa9e3d7225732bb67df01168bf97"); aggregated != want {
+		t.Errorf("aggregated hash %s, want %s", aggregated.Hex(), want.Hex())
+	}
+	signing, err := cpop.MasterSigningHash(ops, master, big.NewInt(7), big.NewInt(56), aggregator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := common.HexToHash("0x830d687aefdd3ed1a7119fe74bccea63bdfb8da1118a5d3f2c2313c347a241e8"); signing != want {
+		t.Errorf("signing hash %s, want %s", signing.Hex(), want.Hex())
+	}
+	// The signing hash is the aggregated hash behind the eth-signed prefix.
+	if prefixed := crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), aggregated.Bytes()); signing != prefixed {
+		t.Errorf("signing hash %s, want %s", signing.Hex(), prefixed.Hex())
+	}
+	// The signature is not part of the hash.
+	signed := append([]cpop.PackedUserOperation{}, ops...)
+	signed[0].Signature = []byte{1, 2, 3}
+	if again, _ := cpop.MasterAggregatedHash(signed, master, big.NewInt(7), big.NewInt(56), aggregator); again != aggregated {
+		t.Errorf("signature changed the aggregated hash to %s", again.Hex())
+	}
+}
+
+// TestMasterBatchSignerContract checks the hashes and signatures of
+// MasterBatchSigner against MasterAggregator.getMasterSigningData and
+// validateSignatures.
+func TestMasterBatchSignerContract(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregator := core.Addresses.MasterAggregator
+	mined := func(tx interface{ Hash() common.Hash }, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		sim.Commit()
+	}
+	// The maxAggregatedOps initializer does not run behind the proxy, which
+	// accepts no batch until updateConfig is called.
+	mined(core.MasterAggregator.UpdateConfig(auth, big.NewInt(50), big.NewInt(300)))
+
+	// Two accounts of the default master signer, the deployer.
+	var ops []cpop.PackedUserOperation
+	for i, owner := range []common.Address{common.HexToAddress("0xc1"), common.HexToAddress("0xc2")} {
+		mined(core.AccountManager.CreateAccount(auth, owner, common.Address{}))
+		account, err := core.AccountManager.GetAccountAddress(nil, owner, common.Address{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		callData, err := cpop.EncodeExecute(owner, big.NewInt(int64(i)), []byte{byte(i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		op := testUserOp(t)
+		op.Sender, op.CallData, op.InitCode, op.PaymasterAndData = account, callData, []byte{}, []byte{0xab}
+		ops = append(ops, op)
+	}
+
+	signer, err := cpop.NewMasterBatchSigner(nil, sim.Client(), aggregator, cpoptest.ChainID, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := &cpop.MasterAggregatorRaw{Contract: core.MasterAggregator}
+	validate := func(ops []cpop.PackedUserOperation, signature []byte) error {
+		var out []interface{}
+		return raw.Call(&bind.CallOpts{From: auth.From}, &out, "validateSignatures", ops, signature)
+	}
+	wantRevert := func(err error, reason string) {
+		t.Helper()
+		if revert, ok := cpop.DecodeRevert(err); !ok || revert.Reason != reason {
+			t.Errorf("validateSignatures = %v, want %q", err, reason)
+		}
+	}
+
+	for nonce := int64(0); nonce < 2; nonce++ {
+		data, err := core.MasterAggregator.GetMasterSigningData(nil, ops, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data.Nonce.Int64() != nonce || signer.Nonce().Int64() != nonce {
+			t.Fatalf("contract nonce %s, signer nonce %s, want %d", data.Nonce, signer.Nonce(), nonce)
+		}
+		signing, err := cpop.MasterSigningHash(ops, auth.From, data.Nonce, cpoptest.ChainID, aggregator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signing != data.HashToSign {
+			t.Fatalf("signing hash %s, contract %s", signing.Hex(), common.Hash(data.HashToSign).Hex())
+		}
+
+		batch, err := signer.SignBatch(nil, ops)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signing, _ := cpop.MasterSigningHash(batch.UserOps, batch.Master, batch.Nonce, cpoptest.ChainID, aggregator); signing != data.HashToSign {
+			t.Errorf("batch signing hash %s, contract %s", signing.Hex(), common.Hash(data.HashToSign).Hex())
+		}
+		if err := validate(batch.UserOps, batch.Signature); err != nil {
+			t.Fatalf("validateSignatures: %v", cpop.AsRevertError(err))
+		}
+
+		// A signature of the aggregated hash without the prefix, or of
+		// other operations, is turned down.
+		bare, err := crypto.Sign(batch.AggregatedHash.Bytes(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bare[crypto.RecoveryIDOffset] += 27
+		signature, err := cpop.EncodeMasterAggregatedSignature(auth.From, batch.Nonce, bare)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantRevert(validate(batch.UserOps, signature), "invalid master signature")
+		tampered := append([]cpop.PackedUserOperation{}, batch.UserOps...)
+		tampered[1].PreVerificationGas = big.NewInt(50001)
+		wantRevert(validate(tampered, batch.Signature), "invalid master signature")
+
+		// Validating for real uses up the nonce, so the batch cannot be
+		// replayed.
+		mined(core.MasterAggregator.ValidateSignatures(auth, batch.UserOps, batch.Signature))
+		wantRevert(validate(batch.UserOps, batch.Signature), "invalid nonce")
+	}
+}
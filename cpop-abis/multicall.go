@@ -0,0 +1,254 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DefaultMulticallBatchSize is the number of calls per aggregate3 used by
+// Multicall when none is given.
+const DefaultMulticallBatchSize = 100
+
+// Multicall3Address is the address Multicall3 is deployed at on BSC, opBNB
+// and their testnets, as on most EVM chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// multicall3MetaData holds the Multicall3 functions used by the package.
+var multicall3MetaData = &bind.MetaData{
+	ABI: `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"},` +
+		`{"inputs":[],"name":"getBlockNumber","outputs":[{"internalType":"uint256","name":"blockNumber","type":"uint256"}],"stateMutability":"view","type":"function"},` +
+		`{"inputs":[],"name":"getCurrentBlockTimestamp","outputs":[{"internalType":"uint256","name":"timestamp","type":"uint256"}],"stateMutability":"view","type":"function"}]`,
+}
+
+// Multicall3Call is one call of an aggregate3 batch.
+type Multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// Multicall3Result is the outcome of one call of an aggregate3 batch.
+type Multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Err returns nil for a successful call, and the decoded revert otherwise.
+func (r Multicall3Result) Err() error {
+	if r.Success {
+		return nil
+	}
+	if revert, ok := DecodeRevertData(r.ReturnData); ok {
+		return revert
+	}
+	if len(r.ReturnData) == 0 {
+		return errors.New("call reverted")
+	}
+	return fmt.Errorf("call reverted: %s", hexutil.Encode(r.ReturnData))
+}
+
+// Aggregate3 runs calls in a single eth_call of Multicall3.aggregate3 at
+// multicall. The results are in the order of calls. A failing call that does
+// not allow failure reverts the whole batch.
+func Aggregate3(opts *bind.CallOpts, backend bind.ContractCaller, multicall common.Address, calls []Multicall3Call) ([]Multicall3Result, error) {
+	parsed, err := multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(multicall, *parsed, backend, nil, nil)
+	var out []interface{}
+	if err := contract.Call(opts, &out, "aggregate3", calls); err != nil {
+		return nil, AsRevertError(err)
+	}
+	results := *abi.ConvertType(out[0], new([]Multicall3Result)).(*[]Multicall3Result)
+	if len(results) != len(calls) {
+		return nil, fmt.Errorf("aggregate3 returned %d results for %d calls", len(results), len(calls))
+	}
+	return results, nil
+}
+
+// MulticallCall is a view call of any binding, packed from its ABI and
+// decoded into the types its XCaller method returns.
+type MulticallCall struct {
+	Target common.Address
+	Method string
+	Args   []interface{}
+	// AllowFailure lets the call fail without failing Multicall.Call; its
+	// error is then left in Err.
+	AllowFailure bool
+	// Out, if not nil, points at a value of the type the XCaller method
+	// returns, e.g. *StakingReaderUserStakingSummary or, for methods with
+	// several outputs, a struct with a field per output.
+	Out interface{}
+
+	Result []interface{} // unpacked outputs, set on success
+	Err    error         // set on failure
+
+	abi *abi.ABI
+}
+
+// NewMulticallCall builds a call of method on the contract described by meta,
+// such as GasPaymasterMetaData, at target. out may be nil.
+func NewMulticallCall(meta *bind.MetaData, target common.Address, out interface{}, method string, args ...interface{}) (*MulticallCall, error) {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := parsed.Methods[method]; !ok {
+		return nil, fmt.Errorf("method %q not found in ABI", method)
+	}
+	if out != nil {
+		if v := reflect.ValueOf(out); v.Kind() != reflect.Pointer || v.IsNil() {
+			return nil, fmt.Errorf("%s: out must be a non-nil pointer, got %T", method, out)
+		}
+	}
+	return &MulticallCall{Target: target, Method: method, Args: args, Out: out, abi: parsed}, nil
+}
+
+// set records the unpacked outputs of c and copies them into Out the way
+// the generated XCaller methods convert them.
+func (c *MulticallCall) set(out []interface{}) error {
+	c.Result = out
+	if c.Out == nil {
+		return nil
+	}
+	if len(out) == 1 {
+		reflect.ValueOf(c.Out).Elem().Set(reflect.ValueOf(abi.ConvertType(out[0], c.Out)).Elem())
+		return nil
+	}
+	return c.abi.Methods[c.Method].Outputs.Copy(c.Out, out)
+}
+
+// Multicall batches MulticallCalls into Multicall3.aggregate3 eth_calls.
+type Multicall struct {
+	Address  common.Address // Multicall3Address by default
+	MaxBatch int            // calls per aggregate3, DefaultMulticallBatchSize if zero
+
+	backend bind.ContractCaller
+}
+
+// NewMulticall returns a Multicall using the Multicall3 at Multicall3Address.
+func NewMulticall(backend bind.ContractCaller) *Multicall {
+	return &Multicall{Address: Multicall3Address, backend: backend}
+}
+
+// Call runs calls in batches of MaxBatch and returns the block they were
+// read at. Without a block number in opts, the block of the first batch is
+// read and the following batches are pinned to it; pending calls are not
+// pinned.
+//
+// A batch the node rejects, for example for exceeding the gas cap of
+// eth_call or because a call not allowing failure reverted, is split in
+// halves and retried. A single failing call is retried on its own to learn
+// its revert reason. Call fails on the first call not allowing failure that
+// failed.
+func (m *Multicall) Call(opts *bind.CallOpts, calls ...*MulticallCall) (*big.Int, error) {
+	pinned := new(bind.CallOpts)
+	if opts != nil {
+		*pinned = *opts
+	}
+	blockCall, err := NewMulticallCall(multicall3MetaData, m.Address, new(*big.Int), "getBlockNumber")
+	if err != nil {
+		return nil, err
+	}
+	all := append([]*MulticallCall{blockCall}, calls...)
+	data := make(map[*MulticallCall][]byte, len(all))
+	for _, c := range all {
+		c.Result, c.Err = nil, nil
+		if data[c], err = c.abi.Pack(c.Method, c.Args...); err != nil {
+			return nil, fmt.Errorf("pack %s: %w", c.Method, err)
+		}
+	}
+
+	size := m.MaxBatch
+	if size <= 0 {
+		size = DefaultMulticallBatchSize
+	}
+	for start := 0; start < len(all); start += size {
+		if err := m.run(pinned, all[start:min(start+size, len(all))], data, blockCall); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range all {
+		if c.Err != nil && !c.AllowFailure {
+			return nil, fmt.Errorf("%s on %s: %w", c.Method, c.Target.Hex(), c.Err)
+		}
+	}
+	if blockCall.Err != nil {
+		return nil, blockCall.Err
+	}
+	return *blockCall.Out.(**big.Int), nil
+}
+
+// run sends one aggregate3 batch, splitting it when the node rejects it.
+func (m *Multicall) run(opts *bind.CallOpts, calls []*MulticallCall, data map[*MulticallCall][]byte, blockCall *MulticallCall) error {
+	batch := make([]Multicall3Call, len(calls))
+	for i, c := range calls {
+		batch[i] = Multicall3Call{Target: c.Target, AllowFailure: c.AllowFailure, CallData: data[c]}
+	}
+	results, err := Aggregate3(opts, m.backend, m.Address, batch)
+	if err != nil {
+		if !rejected(err) {
+			return err
+		}
+		if len(calls) == 1 {
+			m.single(opts, calls[0])
+			m.pin(opts, blockCall)
+			return nil
+		}
+		half := len(calls) / 2
+		if err := m.run(opts, calls[:half], data, blockCall); err != nil {
+			return err
+		}
+		return m.run(opts, calls[half:], data, blockCall)
+	}
+	for i, c := range calls {
+		err := results[i].Err()
+		if err == nil {
+			var out []interface{}
+			if out, err = c.abi.Unpack(c.Method, results[i].ReturnData); err == nil {
+				err = c.set(out)
+			}
+		}
+		c.Err = err
+	}
+	m.pin(opts, blockCall)
+	return nil
+}
+
+// single runs c without Multicall3, which reports its own revert reason.
+func (m *Multicall) single(opts *bind.CallOpts, c *MulticallCall) {
+	contract := bind.NewBoundContract(c.Target, *c.abi, m.backend, nil, nil)
+	var out []interface{}
+	if err := contract.Call(opts, &out, c.Method, c.Args...); err != nil {
+		c.Err = AsRevertError(err)
+		return
+	}
+	c.Err = c.set(out)
+}
+
+// pin fixes opts to the block read by blockCall once it succeeded.
+func (m *Multicall) pin(opts *bind.CallOpts, blockCall *MulticallCall) {
+	if opts.BlockNumber != nil || opts.Pending || blockCall.Result == nil {
+		return
+	}
+	opts.BlockNumber = *blockCall.Out.(**big.Int)
+}
+
+// rejected reports whether err is an answer of the node, such as a revert or
+// an exceeded gas cap, rather than a transport failure.
+func rejected(err error) bool {
+	if _, ok := DecodeRevert(err); ok {
+		return true
+	}
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
@@ -0,0 +1,289 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// gasCapError is the answer of a node to an eth_call above its gas cap.
+type gasCapError struct{}
+
+func (gasCapError) Error() string  { return "gas required exceeds allowance" }
+func (gasCapError) ErrorCode() int { return -32000 }
+
+// recordingCaller records the block of each eth_call to Multicall3 and
+// rejects those with more than maxData bytes of calldata, if set. before is
+// run ahead of each eth_call.
+type recordingCaller struct {
+	bind.ContractCaller
+	maxData  int
+	before   func()
+	blocks   []*big.Int
+	rejected int
+}
+
+func (c *recordingCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if c.before != nil {
+		c.before()
+	}
+	if *call.To == cpop.Multicall3Address {
+		c.blocks = append(c.blocks, blockNumber)
+		if c.maxData > 0 && len(call.Data) > c.maxData {
+			c.rejected++
+			return nil, gasCapError{}
+		}
+	}
+	return c.ContractCaller.CallContract(ctx, call, blockNumber)
+}
+
+// multicallFixture is a Marketplace with a fixed price listing of the
+// seller's token 1 and an auction of token 2 with one bid.
+type multicallFixture struct {
+	*cpoptest.Market
+	sim            *simulated.Backend
+	nftAuth        *bind.TransactOpts // CPNFT and MockUSDT owner
+	seller, bidder common.Address
+}
+
+func newMulticallFixture(t *testing.T) *multicallFixture {
+	t.Helper()
+	nftKey, _ := crypto.GenerateKey()
+	marketKey, _ := crypto.GenerateKey()
+	bidderKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(nftKey, marketKey, bidderKey)
+	t.Cleanup(func() { sim.Close() })
+	nftAuth, marketAuth, bidder := cpoptest.NewTransactor(nftKey), cpoptest.NewTransactor(marketKey), cpoptest.NewTransactor(bidderKey)
+	seller := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := cpoptest.DeployMarket(sim, nftAuth, marketAuth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(m.CPNFT.Mint(nftAuth, seller, levelC))
+	mine(m.CPNFT.Mint(nftAuth, seller, levelB))
+	mine(m.MockUSDT.Mint(nftAuth, bidder.From, big.NewInt(1e9)))
+	mine(m.MockUSDT.Approve(bidder, m.Addresses.Marketplace, big.NewInt(1e9)))
+	mine(m.Marketplace.CreateListing(marketAuth, seller, big.NewInt(1), uint8(cpop.ListingFixedPrice), big.NewInt(300), big.NewInt(0), big.NewInt(0)))
+	mine(m.Marketplace.CreateListing(marketAuth, seller, big.NewInt(2), uint8(cpop.ListingAuction), big.NewInt(100), big.NewInt(3600), big.NewInt(10)))
+	mine(m.Marketplace.PlaceBid(marketAuth, big.NewInt(2), bidder.From, bidder.From, big.NewInt(120)))
+	return &multicallFixture{m, sim, nftAuth, seller, bidder.From}
+}
+
+func TestMulticall(t *testing.T) {
+	m := newMulticallFixture(t)
+	seller, bidder, multicall := m.seller, m.bidder, cpop.NewMulticall(m.sim.Client())
+	meta := cpop.MarketplaceMetaData
+
+	// Results decode into the types the generated callers return.
+	var (
+		listing  cpop.IMarketplaceListing
+		bids     []cpop.IMarketplaceBid
+		userIDs  []*big.Int
+		balance  *big.Int
+		delisted struct {
+			Count          *big.Int
+			LastResetTime  *big.Int
+			RemainingCount *big.Int
+		}
+		noBid cpop.IMarketplaceBid
+	)
+	calls := []*cpop.MulticallCall{
+		mustMulticallCall(t, meta, m.Addresses.Marketplace, &listing, "getListing", big.NewInt(1)),
+		mustMulticallCall(t, meta, m.Addresses.Marketplace, &bids, "getBids", big.NewInt(2)),
+		mustMulticallCall(t, meta, m.Addresses.Marketplace, &userIDs, "getUserListings", seller),
+		mustMulticallCall(t, cpop.MockUSDTMetaData, m.Addresses.MockUSDT, &balance, "balanceOf", bidder),
+		mustMulticallCall(t, meta, m.Addresses.Marketplace, &delisted, "getDelistingRecord", seller),
+		mustMulticallCall(t, meta, m.Addresses.Marketplace, &noBid, "getHighestBid", big.NewInt(1)),
+	}
+	// A failing call allowed to fail leaves its revert in Err.
+	calls[5].AllowFailure = true
+	block, err := multicall.Call(nil, calls...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &bind.CallOpts{BlockNumber: block}
+	wantListing, err := m.Marketplace.GetListing(opts, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBids, err := m.Marketplace.GetBids(opts, big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantIDs, err := m.Marketplace.GetUserListings(opts, seller)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBalance, err := m.MockUSDT.BalanceOf(opts, bidder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDelisted, err := m.Marketplace.GetDelistingRecord(opts, seller)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"getListing", listing, wantListing},
+		{"getBids", bids, wantBids},
+		{"getUserListings", userIDs, wantIDs},
+		{"balanceOf", balance, wantBalance},
+		{"getDelistingRecord", delisted, wantDelisted},
+	} {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s = %+v, want %+v", c.name, c.got, c.want)
+		}
+	}
+	if listing.TokenId.Int64() != 1 || len(bids) != 1 || bids[0].Amount.Int64() != 120 || len(userIDs) != 2 {
+		t.Errorf("listing %+v, bids %+v, listings %v", listing, bids, userIDs)
+	}
+	var revert *cpop.RevertError
+	if !errors.As(calls[5].Err, &revert) || revert.Reason != "No bids placed" || calls[5].Result != nil {
+		t.Fatalf("getHighestBid err = %v, result %v", calls[5].Err, calls[5].Result)
+	}
+	for _, c := range calls[:5] {
+		if c.Err != nil {
+			t.Errorf("%s: %v", c.Method, c.Err)
+		}
+	}
+
+	// Without AllowFailure the batch reverts; the call is retried on its own
+	// and Call reports its reason.
+	calls[5].AllowFailure = false
+	if _, err := multicall.Call(nil, calls...); !errors.As(err, &revert) || revert.Reason != "No bids placed" {
+		t.Fatalf("err = %v, want the getHighestBid revert", err)
+	}
+}
+
+func TestMulticallSplit(t *testing.T) {
+	m := newMulticallFixture(t)
+	seller, bidder := m.seller, m.bidder
+	caller := &recordingCaller{ContractCaller: m.sim.Client(), maxData: 1200}
+	multicall := cpop.NewMulticall(caller)
+
+	var balances [8]*big.Int
+	calls := make([]*cpop.MulticallCall, len(balances))
+	for i := range calls {
+		holder := bidder
+		if i%2 == 1 {
+			holder = seller
+		}
+		calls[i] = mustMulticallCall(t, cpop.MockUSDTMetaData, m.Addresses.MockUSDT, &balances[i], "balanceOf", holder)
+	}
+	block, err := multicall.Call(nil, calls...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if caller.rejected == 0 {
+		t.Fatal("no batch was rejected, raise the number of calls")
+	}
+	for i, balance := range balances {
+		want := int64(1e9 - 120)
+		if i%2 == 1 {
+			want = 0
+		}
+		if calls[i].Err != nil || balance == nil || balance.Int64() != want {
+			t.Errorf("call %d = %v, %v; want %d", i, balance, calls[i].Err, want)
+		}
+	}
+	// The halves after the first answered batch are pinned to its block.
+	for i, b := range caller.blocks[1:] {
+		if b != nil && b.Cmp(block) != 0 {
+			t.Errorf("eth_call %d at block %s, want %s", i+1, b, block)
+		}
+	}
+}
+
+func TestMulticallPinsBlock(t *testing.T) {
+	m := newMulticallFixture(t)
+	// The seller receives USDT in a new block after the first batch.
+	caller := &recordingCaller{ContractCaller: m.sim.Client()}
+	caller.before = func() {
+		if len(caller.blocks) != 1 {
+			return
+		}
+		tx, err := m.MockUSDT.Mint(m.nftAuth, m.seller, big.NewInt(500))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cpoptest.Mine(m.sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	multicall := cpop.NewMulticall(caller)
+	multicall.MaxBatch = 2
+
+	var balances [5]*big.Int
+	calls := make([]*cpop.MulticallCall, len(balances))
+	for i := range calls {
+		calls[i] = mustMulticallCall(t, cpop.MockUSDTMetaData, m.Addresses.MockUSDT, &balances[i], "balanceOf", m.seller)
+	}
+	block, err := multicall.Call(nil, calls...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(caller.blocks) != 3 || caller.blocks[0] != nil {
+		t.Fatalf("eth_calls at %v, want 3 batches starting at the latest block", caller.blocks)
+	}
+	for i, b := range caller.blocks[1:] {
+		if b == nil || b.Cmp(block) != 0 {
+			t.Errorf("batch %d at block %v, want %s", i+2, b, block)
+		}
+	}
+	for i, balance := range balances {
+		if balance == nil || balance.Sign() != 0 {
+			t.Errorf("balance %d = %v, want the balance before the mint", i, balance)
+		}
+	}
+	if now, err := m.MockUSDT.BalanceOf(nil, m.seller); err != nil || now.Int64() != 500 {
+		t.Fatalf("balance after = %v, %v; want 500", now, err)
+	}
+
+	// A block number in the options pins every batch to it.
+	caller.blocks, caller.before = nil, nil
+	at, err := multicall.Call(&bind.CallOpts{BlockNumber: block}, calls...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if at.Cmp(block) != 0 {
+		t.Errorf("read at %s, want %s", at, block)
+	}
+	for i, b := range caller.blocks {
+		if b == nil || b.Cmp(block) != 0 {
+			t.Errorf("batch %d at block %v, want %s", i+1, b, block)
+		}
+	}
+}
+
+func mustMulticallCall(t *testing.T, meta *bind.MetaData, target common.Address, out interface{}, method string, args ...interface{}) *cpop.MulticallCall {
+	t.Helper()
+	c, err := cpop.NewMulticallCall(meta, target, out, method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
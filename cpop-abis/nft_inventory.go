@@ -0,0 +1,255 @@
+package cpop
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// NFTToken is the inventory entry of a CPNFT token.
+type NFTToken struct {
+	TokenID *big.Int
+	Owner   common.Address
+	Level   uint8 // 0 NORMAL to 6 SSS
+	Staked  bool
+}
+
+// nftUndo restores a token to its state before a log was applied.
+type nftUndo struct {
+	block   uint64
+	tokenID *big.Int
+	prev    *NFTToken // nil if the token did not exist
+}
+
+// NFTInventory is a map of CPNFT tokens to their owner, level and staked flag,
+// kept up to date from the Transfer, TokenLevelSet and TokenStakeStatusChanged
+// events. Events removed by a reorg, which Indexer re-emits in reverse order,
+// are undone.
+type NFTInventory struct {
+	// ReorgDepth is the number of blocks events can be undone for,
+	// DefaultIndexerReorgDepth if zero.
+	ReorgDepth uint64
+
+	mu     sync.RWMutex
+	tokens map[string]*NFTToken
+	owned  map[common.Address]map[string]*NFTToken
+	undo   map[logKey]nftUndo
+	head   uint64
+}
+
+// logKey identifies a log across reorgs.
+type logKey struct {
+	tx    common.Hash
+	index uint
+}
+
+// NewNFTInventory returns an empty inventory.
+func NewNFTInventory() *NFTInventory {
+	return &NFTInventory{
+		tokens: make(map[string]*NFTToken),
+		owned:  make(map[common.Address]map[string]*NFTToken),
+		undo:   make(map[logKey]nftUndo),
+	}
+}
+
+// LoadNFTInventory reads every token minted from fromBlock, usually the CPNFT
+// deployment block, up to the block of opts. CPNFT has no token enumeration,
+// so the minted IDs are taken from the Transfer events and the current state
+// of each is read at opts; burned tokens are skipped. Events after the block
+// of opts can then be applied to keep it current.
+func LoadNFTInventory(opts *bind.CallOpts, nft *CPNFT, fromBlock uint64) (*NFTInventory, error) {
+	if opts == nil {
+		opts = new(bind.CallOpts)
+	}
+	filter := &bind.FilterOpts{Start: fromBlock, Context: opts.Context}
+	if opts.BlockNumber != nil {
+		end := opts.BlockNumber.Uint64()
+		filter.End = &end
+	}
+	it, err := nft.FilterTransfer(filter, []common.Address{{}}, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("minted tokens: %w", err)
+	}
+	defer it.Close()
+	var minted []*big.Int
+	for it.Next() {
+		minted = append(minted, it.Event.TokenId)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("minted tokens: %w", err)
+	}
+
+	inv := NewNFTInventory()
+	for _, id := range minted {
+		t := &NFTToken{TokenID: id}
+		if t.Owner, err = nft.OwnerOf(opts, id); err != nil {
+			if _, ok := DecodeRevert(err); ok {
+				continue // burned
+			}
+			return nil, fmt.Errorf("owner of token %s: %w", id, err)
+		}
+		if t.Level, err = nft.GetTokenLevel(opts, id); err != nil {
+			return nil, fmt.Errorf("level of token %s: %w", id, err)
+		}
+		if t.Staked, err = nft.IsStaked(opts, id); err != nil {
+			return nil, fmt.Errorf("stake status of token %s: %w", id, err)
+		}
+		inv.put(t)
+	}
+	return inv, nil
+}
+
+// Apply applies a CPNFT event binding, such as *CPNFTTransfer or the Event of
+// an IndexedEvent. Other events are ignored.
+func (inv *NFTInventory) Apply(event any) error {
+	var (
+		raw *types.Log
+		id  *big.Int
+	)
+	switch ev := event.(type) {
+	case *CPNFTTransfer:
+		raw, id = &ev.Raw, ev.TokenId
+	case *CPNFTTokenLevelSet:
+		raw, id = &ev.Raw, ev.TokenId
+	case *CPNFTTokenStakeStatusChanged:
+		raw, id = &ev.Raw, ev.TokenId
+	default:
+		return nil
+	}
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	key := logKey{raw.TxHash, raw.Index}
+	if raw.Removed {
+		u, ok := inv.undo[key]
+		if !ok {
+			return fmt.Errorf("removed log %s:%d of token %s was not applied or is too old", raw.TxHash.Hex(), raw.Index, id)
+		}
+		delete(inv.undo, key)
+		inv.remove(u.tokenID)
+		if u.prev != nil {
+			inv.put(u.prev)
+		}
+		return nil
+	}
+
+	prev := inv.tokens[id.String()]
+	u := nftUndo{block: raw.BlockNumber, tokenID: id}
+	t := &NFTToken{TokenID: id}
+	if prev != nil {
+		saved, next := *prev, *prev
+		u.prev, t = &saved, &next
+	}
+	inv.remove(id)
+	switch ev := event.(type) {
+	case *CPNFTTransfer:
+		if ev.To == (common.Address{}) {
+			inv.record(key, u)
+			return nil // burned
+		}
+		t.Owner = ev.To
+	case *CPNFTTokenLevelSet:
+		t.Level = ev.Level
+	case *CPNFTTokenStakeStatusChanged:
+		t.Staked = ev.IsStaked
+	}
+	inv.put(t)
+	inv.record(key, u)
+	return nil
+}
+
+// record keeps the undo entry of a log and forgets those past the reorg depth.
+func (inv *NFTInventory) record(key logKey, u nftUndo) {
+	inv.undo[key] = u
+	if u.block <= inv.head {
+		return
+	}
+	inv.head = u.block
+	depth := inv.ReorgDepth
+	if depth == 0 {
+		depth = DefaultIndexerReorgDepth
+	}
+	if inv.head <= depth {
+		return
+	}
+	for k, old := range inv.undo {
+		if old.block < inv.head-depth {
+			delete(inv.undo, k)
+		}
+	}
+}
+
+// put adds t to the token and owner indexes.
+func (inv *NFTInventory) put(t *NFTToken) {
+	key := t.TokenID.String()
+	inv.tokens[key] = t
+	if t.Owner == (common.Address{}) {
+		return
+	}
+	if inv.owned[t.Owner] == nil {
+		inv.owned[t.Owner] = make(map[string]*NFTToken)
+	}
+	inv.owned[t.Owner][key] = t
+}
+
+// remove drops the token from the token and owner indexes.
+func (inv *NFTInventory) remove(id *big.Int) {
+	key := id.String()
+	t := inv.tokens[key]
+	if t == nil {
+		return
+	}
+	delete(inv.tokens, key)
+	if owned := inv.owned[t.Owner]; owned != nil {
+		delete(owned, key)
+		if len(owned) == 0 {
+			delete(inv.owned, t.Owner)
+		}
+	}
+}
+
+// Len returns the number of tokens in the inventory.
+func (inv *NFTInventory) Len() int {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	return len(inv.tokens)
+}
+
+// Token returns a copy of the token, or nil if it does not exist.
+func (inv *NFTInventory) Token(id *big.Int) *NFTToken {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	t := inv.tokens[id.String()]
+	if t == nil {
+		return nil
+	}
+	copied := *t
+	return &copied
+}
+
+// Holdings returns copies of the tokens of owner ordered by token ID.
+func (inv *NFTInventory) Holdings(owner common.Address) []NFTToken {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	tokens := make([]NFTToken, 0, len(inv.owned[owner]))
+	for _, t := range inv.owned[owner] {
+		tokens = append(tokens, *t)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].TokenID.Cmp(tokens[j].TokenID) < 0 })
+	return tokens
+}
+
+// HoldingsByLevel returns the token IDs of owner grouped by level, each
+// ordered by token ID.
+func (inv *NFTInventory) HoldingsByLevel(owner common.Address) map[uint8][]*big.Int {
+	byLevel := make(map[uint8][]*big.Int)
+	for _, t := range inv.Holdings(owner) {
+		byLevel[t.Level] = append(byLevel[t.Level], t.TokenID)
+	}
+	return byLevel
+}
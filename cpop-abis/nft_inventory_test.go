@@ -0,0 +1,161 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// removedEvent returns a copy of a CPNFT inventory event as delivered again
+// after a reorg. Other events, which NFTInventory ignores, are returned as is.
+func removedEvent(event any) any {
+	switch ev := event.(type) {
+	case *cpop.CPNFTTransfer:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.CPNFTTokenLevelSet:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	case *cpop.CPNFTTokenStakeStatusChanged:
+		c := *ev
+		c.Raw.Removed = true
+		return &c
+	}
+	return event
+}
+
+// sameInventory checks that got and want hold the same tokens up to maxID
+// and the same holdings of owners.
+func sameInventory(t *testing.T, got, want *cpop.NFTInventory, maxID int64, owners ...common.Address) {
+	t.Helper()
+	if got.Len() != want.Len() {
+		t.Fatalf("%d tokens, want %d", got.Len(), want.Len())
+	}
+	for id := int64(1); id <= maxID; id++ {
+		if g, w := got.Token(big.NewInt(id)), want.Token(big.NewInt(id)); !reflect.DeepEqual(g, w) {
+			t.Errorf("token %d = %+v, want %+v", id, g, w)
+		}
+	}
+	for _, owner := range owners {
+		if g, w := got.HoldingsByLevel(owner), want.HoldingsByLevel(owner); !reflect.DeepEqual(g, w) {
+			t.Errorf("holdings of %s = %v, want %v", owner.Hex(), g, w)
+		}
+	}
+}
+
+func TestNFTInventory(t *testing.T) {
+	ctx := context.Background()
+	ownerKey, _ := crypto.GenerateKey() // CPNFT owner, also acts as the staking contract
+	aliceKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(ownerKey, aliceKey)
+	defer sim.Close()
+	client := sim.Client()
+	owner, alice := cpoptest.NewTransactor(ownerKey), cpoptest.NewTransactor(aliceKey)
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		if err := cpoptest.Mine(sim, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nftAddr, nft, err := cpoptest.DeployCPNFT(sim, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployed, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(nft.SetStakingContract(owner, owner.From))
+
+	mine(nft.Mint(owner, alice.From, levelC)) // 1
+	mine(nft.Mint(owner, alice.From, levelB)) // 2
+	mine(nft.Mint(owner, owner.From, levelC)) // 3
+	mine(nft.SetTokenLevel(owner, big.NewInt(2), levelB+1))
+	mine(nft.SetStakeStatus(owner, big.NewInt(1), true))
+	mine(nft.TransferFrom(alice, alice.From, owner.From, big.NewInt(2)))
+	mine(nft.Burn(owner, big.NewInt(3)))
+	base, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The inventory built from events equals the one read from the chain.
+	manifest := &cpop.Manifest{Network: "simulated", Contracts: map[string]common.Address{"CPNFT": nftAddr}}
+	ix, err := cpop.NewIndexer(client, manifest, cpop.NewMemoryIndexerStore(), cpop.IndexerConfig{FromBlock: deployed.Number.Uint64()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inv := cpop.NewNFTInventory()
+	var applied []any
+	handle := func(ev *cpop.IndexedEvent) error {
+		applied = append(applied, ev.Event)
+		return inv.Apply(ev.Event)
+	}
+	if _, err := ix.Sync(ctx, handle); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := cpop.LoadNFTInventory(nil, nft, deployed.Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameInventory(t, inv, loaded, 3, alice.From, owner.From)
+	want := []cpop.NFTToken{
+		{TokenID: big.NewInt(1), Owner: alice.From, Level: levelC, Staked: true},
+		{TokenID: big.NewInt(2), Owner: owner.From, Level: levelB + 1},
+	}
+	for _, w := range want {
+		if got := inv.Token(w.TokenID); got == nil || !reflect.DeepEqual(*got, w) {
+			t.Errorf("token %s = %+v, want %+v", w.TokenID, got, w)
+		}
+	}
+	if inv.Token(big.NewInt(3)) != nil {
+		t.Error("burned token 3 in the inventory")
+	}
+
+	// Events removed by a reorg, delivered newest first, restore the state
+	// of the block before them.
+	applied = nil
+	mine(nft.Mint(owner, alice.From, levelC)) // 4
+	mine(nft.SetStakeStatus(owner, big.NewInt(1), false))
+	mine(nft.TransferFrom(alice, alice.From, owner.From, big.NewInt(1)))
+	mine(nft.SetTokenLevel(owner, big.NewInt(1), levelB))
+	mine(nft.Burn(owner, big.NewInt(2)))
+	if _, err := ix.Sync(ctx, handle); err != nil {
+		t.Fatal(err)
+	}
+	after, err := cpop.LoadNFTInventory(nil, nft, deployed.Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameInventory(t, inv, after, 4, alice.From, owner.From)
+	for i := len(applied) - 1; i >= 0; i-- {
+		if err := inv.Apply(removedEvent(applied[i])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before, err := cpop.LoadNFTInventory(&bind.CallOpts{BlockNumber: base.Number}, nft, deployed.Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameInventory(t, inv, before, 4, alice.From, owner.From)
+	sameInventory(t, before, loaded, 4, alice.From, owner.From)
+
+	// Undoing a log twice is an error.
+	if err := inv.Apply(removedEvent(applied[0])); err == nil {
+		t.Fatal("removal applied twice")
+	}
+}
@@ -0,0 +1,286 @@
+package cpop
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultMintChunkGas is the gas budget of one BatchMint transaction used by
+// PlanMints when none is given.
+const DefaultMintChunkGas = 10_000_000
+
+var (
+	// ErrMintPlanMismatch is returned when resuming a plan with the progress
+	// of another one.
+	ErrMintPlanMismatch = errors.New("mint progress belongs to another plan")
+	// ErrMintChunkTooLarge is returned when the gas budget does not fit a
+	// single mint.
+	ErrMintChunkTooLarge = errors.New("chunk gas budget too small for one mint")
+	// ErrMintPendingUnknown is returned when the nonce of the saved
+	// transaction was used but the node has no receipt for it, so whether it
+	// minted cannot be told. Check the chain, e.g. the owner of the tokens it
+	// would have minted, and call MintProgress.Resolve.
+	ErrMintPendingUnknown = errors.New("saved mint transaction has no receipt but its nonce was used")
+)
+
+// MintRequest is one token of a mint campaign.
+type MintRequest struct {
+	To    common.Address
+	Level uint8
+}
+
+// MintGasModel approximates the gas of a BatchMint of n tokens as
+// Base + PerMint*n.
+type MintGasModel struct {
+	Base    uint64
+	PerMint uint64
+}
+
+// Gas returns the modelled gas of a BatchMint of n tokens.
+func (m MintGasModel) Gas(n int) uint64 {
+	return m.Base + m.PerMint*uint64(n)
+}
+
+// EstimateMintGas calibrates a MintGasModel by estimating BatchMint of one
+// and of two tokens from from, which must be the CPNFT owner. Recipients are
+// fresh addresses, the most expensive case.
+func EstimateMintGas(ctx context.Context, backend bind.ContractBackend, nft common.Address, from common.Address) (MintGasModel, error) {
+	parsed, err := CPNFTMetaData.GetAbi()
+	if err != nil {
+		return MintGasModel{}, err
+	}
+	var gas [2]uint64
+	for i := range gas {
+		n := i + 1
+		to := make([]common.Address, n)
+		for j := range to {
+			to[j] = common.BytesToAddress(crypto.Keccak256([]byte("cpop mint gas"), []byte{byte(j)}))
+		}
+		data, err := parsed.Pack("batchMint", to, make([]uint8, n))
+		if err != nil {
+			return MintGasModel{}, err
+		}
+		if gas[i], err = backend.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &nft, Data: data}); err != nil {
+			return MintGasModel{}, fmt.Errorf("estimate batch mint of %d: %w", n, AsRevertError(err))
+		}
+	}
+	perMint := gas[1] - gas[0]
+	return MintGasModel{Base: gas[0] - perMint, PerMint: perMint}, nil
+}
+
+// MintChunk is one BatchMint transaction of a plan.
+type MintChunk struct {
+	Start  int // index of the first request
+	To     []common.Address
+	Levels []uint8
+	Gas    uint64 // modelled gas
+}
+
+// MintPlan splits a mint campaign into gas-bounded BatchMint chunks.
+type MintPlan struct {
+	ID       common.Hash // hash of the requests, ties progress to the plan
+	Requests []MintRequest
+	Chunks   []MintChunk
+}
+
+// PlanMints splits requests into chunks whose modelled gas stays within
+// chunkGas, or DefaultMintChunkGas if zero.
+func PlanMints(requests []MintRequest, gas MintGasModel, chunkGas uint64) (*MintPlan, error) {
+	if chunkGas == 0 {
+		chunkGas = DefaultMintChunkGas
+	}
+	if gas.PerMint == 0 || chunkGas < gas.Gas(1) {
+		return nil, fmt.Errorf("%w: %d < %d", ErrMintChunkTooLarge, chunkGas, gas.Gas(1))
+	}
+	size := int((chunkGas - gas.Base) / gas.PerMint)
+
+	plan := &MintPlan{Requests: requests}
+	hasher := crypto.NewKeccakState()
+	for _, r := range requests {
+		hasher.Write(r.To.Bytes())
+		hasher.Write([]byte{r.Level})
+	}
+	hasher.Read(plan.ID[:])
+	for start := 0; start < len(requests); start += size {
+		part := requests[start:min(start+size, len(requests))]
+		chunk := MintChunk{Start: start, To: make([]common.Address, len(part)), Levels: make([]uint8, len(part)), Gas: gas.Gas(len(part))}
+		for i, r := range part {
+			chunk.To[i], chunk.Levels[i] = r.To, r.Level
+		}
+		plan.Chunks = append(plan.Chunks, chunk)
+	}
+	return plan, nil
+}
+
+// MintProgress records how far a plan has been executed. It is meant to be
+// persisted, e.g. as JSON, between runs. Progress counts requests rather than
+// chunks, so it stays valid when the plan is rebuilt with another gas model.
+type MintProgress struct {
+	PlanID common.Hash `json:"planId"`
+	Done   int         `json:"done"` // number of minted requests
+	// Pending is the signed transaction minting from request Done, saved
+	// before it is sent so that it is rebroadcast instead of minted twice
+	// after a crash.
+	Pending hexutil.Bytes `json:"pending,omitempty"`
+}
+
+// Resolve settles the saved transaction after ErrMintPendingUnknown: minted
+// counts its requests as done, otherwise they are minted again by the next
+// Execute.
+func (p *MintProgress) Resolve(minted bool) error {
+	if len(p.Pending) == 0 {
+		return nil
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(p.Pending); err != nil {
+		return fmt.Errorf("saved request %d: %w", p.Done, err)
+	}
+	n, err := batchMintCount(tx.Data())
+	if err != nil {
+		return fmt.Errorf("saved request %d: %w", p.Done, err)
+	}
+	if minted {
+		p.Done += n
+	}
+	p.Pending = nil
+	return nil
+}
+
+// MintBackend is the node access needed to execute a MintPlan.
+type MintBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// Execute mints the requests of p not done in progress one chunk at a time
+// with auth, which must be the CPNFT owner, waiting for each to be mined.
+// When progress stops inside a chunk, the rest of that chunk is minted first.
+// save is called whenever progress changes and must persist it before
+// Execute continues. A reverted chunk stops the run; running again resends it.
+func (p *MintPlan) Execute(ctx context.Context, backend MintBackend, nft *CPNFTTransactor, auth *bind.TransactOpts, progress *MintProgress, save func(*MintProgress) error) error {
+	if progress.PlanID == (common.Hash{}) && progress.Done == 0 {
+		progress.PlanID = p.ID
+	}
+	if progress.PlanID != p.ID {
+		return fmt.Errorf("%w: %s", ErrMintPlanMismatch, progress.PlanID.Hex())
+	}
+	if progress.Done > len(p.Requests) {
+		return fmt.Errorf("%w: %d of %d requests done", ErrMintPlanMismatch, progress.Done, len(p.Requests))
+	}
+	for progress.Done < len(p.Requests) {
+		tx, n, err := p.pending(ctx, backend, auth.From, progress)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			to, levels := p.next(progress.Done)
+			opts := *auth
+			opts.Context, opts.NoSend = ctx, true
+			if tx, err = nft.BatchMint(&opts, to, levels); err != nil {
+				return fmt.Errorf("request %d: %w", progress.Done, AsRevertError(err))
+			}
+			if progress.Pending, err = tx.MarshalBinary(); err != nil {
+				return err
+			}
+			if err := save(progress); err != nil {
+				return err
+			}
+			if err := backend.SendTransaction(ctx, tx); err != nil {
+				return fmt.Errorf("send request %d: %w", progress.Done, err)
+			}
+			n = len(to)
+		}
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			return fmt.Errorf("wait for request %d: %w", progress.Done, err)
+		}
+		failed := receipt.Status != types.ReceiptStatusSuccessful
+		if !failed {
+			progress.Done += n
+		}
+		progress.Pending = nil
+		if err := save(progress); err != nil {
+			return err
+		}
+		if failed {
+			return fmt.Errorf("request %d: transaction %s reverted", progress.Done, tx.Hash().Hex())
+		}
+	}
+	return nil
+}
+
+// next returns the requests from done to the end of the chunk holding it.
+func (p *MintPlan) next(done int) ([]common.Address, []uint8) {
+	for _, chunk := range p.Chunks {
+		if off := done - chunk.Start; off >= 0 && off < len(chunk.To) {
+			return chunk.To[off:], chunk.Levels[off:]
+		}
+	}
+	return nil, nil
+}
+
+// pending returns the saved transaction minting from request Done and the
+// number of requests it mints, if it may still be mined, rebroadcasting it
+// when the node lost it. A transaction whose nonce was used without a receipt
+// to show for it is reported with ErrMintPendingUnknown: it may have been
+// replaced, or mined while the node cannot look it up.
+func (p *MintPlan) pending(ctx context.Context, backend MintBackend, from common.Address, progress *MintProgress) (*types.Transaction, int, error) {
+	if len(progress.Pending) == 0 {
+		return nil, 0, nil
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(progress.Pending); err != nil {
+		return nil, 0, fmt.Errorf("saved request %d: %w", progress.Done, err)
+	}
+	n, err := batchMintCount(tx.Data())
+	if err != nil {
+		return nil, 0, fmt.Errorf("saved request %d: %w", progress.Done, err)
+	}
+	if progress.Done+n > len(p.Requests) {
+		return nil, 0, fmt.Errorf("%w: saved transaction mints %d requests from %d", ErrMintPlanMismatch, n, progress.Done)
+	}
+	if _, err := backend.TransactionReceipt(ctx, tx.Hash()); err == nil {
+		return tx, n, nil
+	} else if !errors.Is(err, ethereum.NotFound) {
+		return nil, 0, fmt.Errorf("receipt of request %d: %w", progress.Done, err)
+	}
+	nonce, err := backend.NonceAt(ctx, from, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("nonce: %w", err)
+	}
+	if nonce > tx.Nonce() {
+		return nil, 0, fmt.Errorf("%w: request %d, transaction %s", ErrMintPendingUnknown, progress.Done, tx.Hash().Hex())
+	}
+	// Already known transactions are rejected by some nodes, the wait below
+	// tells whether it was accepted.
+	_ = backend.SendTransaction(ctx, tx)
+	return tx, n, nil
+}
+
+// batchMintCount returns the number of tokens minted by BatchMint calldata.
+func batchMintCount(data []byte) (int, error) {
+	parsed, err := CPNFTMetaData.GetAbi()
+	if err != nil {
+		return 0, err
+	}
+	method := parsed.Methods["batchMint"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return 0, errors.New("not a batchMint call")
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return len(args[0].([]common.Address)), nil
+}
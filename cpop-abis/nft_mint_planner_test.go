@@ -0,0 +1,154 @@
+package cpop_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// autoMiner mines a block after every sent transaction.
+type autoMiner struct {
+	simulated.Client
+	sim *simulated.Backend
+}
+
+func (b autoMiner) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.sim.Commit()
+	return nil
+}
+
+// lostReceipts is a node that no longer finds any receipt, e.g. beyond its
+// transaction lookup limit.
+type lostReceipts struct{ autoMiner }
+
+func (lostReceipts) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	return nil, ethereum.NotFound
+}
+
+func TestMintPlanResume(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	client := sim.Client()
+	_, nft, err := cpoptest.DeployCPNFT(sim, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var requests []cpop.MintRequest
+	for i := range 5 {
+		requests = append(requests, cpop.MintRequest{To: common.BigToAddress(big.NewInt(int64(0xa0 + i))), Level: levelC + uint8(i%2)})
+	}
+	model := cpop.MintGasModel{Base: 100_000, PerMint: 100_000}
+	pairs, err := cpop.PlanMints(requests, model, 300_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs.Chunks) != 3 {
+		t.Fatalf("%d chunks of two, want 3", len(pairs.Chunks))
+	}
+
+	// The run crashes after the second chunk is mined but before that is
+	// saved, leaving its transaction pending in the progress.
+	backend := autoMiner{client, sim}
+	crash := errors.New("crash")
+	var saved cpop.MintProgress
+	saves := 0
+	save := func(p *cpop.MintProgress) error {
+		if saves++; saves == 4 {
+			return crash
+		}
+		saved = *p
+		return nil
+	}
+	var progress cpop.MintProgress
+	if err := pairs.Execute(ctx, backend, &nft.CPNFTTransactor, auth, &progress, save); !errors.Is(err, crash) {
+		t.Fatalf("err = %v, want the crash", err)
+	}
+	if saved.Done != 2 || len(saved.Pending) == 0 {
+		t.Fatalf("saved progress %d done, %d pending bytes", saved.Done, len(saved.Pending))
+	}
+
+	// A new gas model splits the same requests at other boundaries. The
+	// saved transaction counts for the two requests it minted and the run
+	// continues from the fifth.
+	triples, err := cpop.PlanMints(requests, model, 400_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if triples.ID != pairs.ID || len(triples.Chunks) != 2 {
+		t.Fatalf("replanned %s in %d chunks", triples.ID.Hex(), len(triples.Chunks))
+	}
+	// A node without the receipt cannot tell whether the saved transaction
+	// minted, so the run stops rather than minting its requests again.
+	unknown := saved
+	if err := triples.Execute(ctx, lostReceipts{backend}, &nft.CPNFTTransactor, auth, &unknown, func(*cpop.MintProgress) error {
+		t.Fatal("unresolved progress saved")
+		return nil
+	}); !errors.Is(err, cpop.ErrMintPendingUnknown) {
+		t.Fatalf("err = %v, want ErrMintPendingUnknown", err)
+	}
+	if unknown.Done != saved.Done || len(unknown.Pending) == 0 {
+		t.Fatalf("progress changed to %d done, %d pending bytes", unknown.Done, len(unknown.Pending))
+	}
+	// Resolving it once the chain shows the tokens counts its requests.
+	if owner, err := nft.OwnerOf(nil, big.NewInt(4)); err != nil || owner != requests[3].To {
+		t.Fatalf("token 4 owner %s, %v", owner.Hex(), err)
+	}
+	if err := unknown.Resolve(true); err != nil || unknown.Done != 4 || len(unknown.Pending) != 0 {
+		t.Fatalf("resolved to %d done, %d pending bytes, %v", unknown.Done, len(unknown.Pending), err)
+	}
+	if unminted := saved; unminted.Resolve(false) != nil || unminted.Done != 2 || len(unminted.Pending) != 0 {
+		t.Fatalf("resolved as not minted to %d done, %d pending bytes", unminted.Done, len(unminted.Pending))
+	}
+
+	resumed := saved
+	if err := triples.Execute(ctx, backend, &nft.CPNFTTransactor, auth, &resumed, func(*cpop.MintProgress) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Done != len(requests) || len(resumed.Pending) != 0 {
+		t.Fatalf("progress %d done, %d pending bytes", resumed.Done, len(resumed.Pending))
+	}
+
+	// Each request was minted once, in order.
+	for i, r := range requests {
+		id := big.NewInt(int64(i + 1))
+		owner, err := nft.OwnerOf(nil, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		level, err := nft.GetTokenLevel(nil, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if owner != r.To || level != r.Level {
+			t.Errorf("token %s: %s level %d, want %s level %d", id, owner.Hex(), level, r.To.Hex(), r.Level)
+		}
+	}
+	if _, err := nft.OwnerOf(nil, big.NewInt(int64(len(requests)+1))); err == nil {
+		t.Error("minted more tokens than requested")
+	}
+
+	// Running a finished plan again sends nothing.
+	if err := triples.Execute(ctx, backend, &nft.CPNFTTransactor, auth, &resumed, func(*cpop.MintProgress) error {
+		t.Fatal("finished plan saved progress")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,284 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Offsets of the paymasterAndData fields, as in UserOperationLib.
+const (
+	PaymasterValidationGasOffset = 20
+	PaymasterPostOpGasOffset     = 36
+	PaymasterDataOffset          = 52
+)
+
+// ErrPaymasterRejected is returned by PaymasterSponsor.Sponsor when the
+// paymaster would reject the operation. It wraps the blocking verdict.
+var ErrPaymasterRejected = errors.New("paymaster would reject operation")
+
+// PackPaymasterAndData returns paymaster, the uint128 paymaster verification
+// and postOp gas limits and data concatenated as the EntryPoint expects. It
+// fails with ErrUint128Range if a gas limit does not fit into uint128.
+func PackPaymasterAndData(paymaster common.Address, verificationGasLimit, postOpGasLimit *big.Int, data []byte) ([]byte, error) {
+	limits, err := PackUints(verificationGasLimit, postOpGasLimit)
+	if err != nil {
+		return nil, fmt.Errorf("paymaster gas limits: %w", err)
+	}
+	out := make([]byte, 0, PaymasterDataOffset+len(data))
+	out = append(out, paymaster.Bytes()...)
+	out = append(out, limits[:]...)
+	return append(out, data...), nil
+}
+
+// UnpackPaymasterAndData splits paymasterAndData into its fields.
+func UnpackPaymasterAndData(paymasterAndData []byte) (paymaster common.Address, verificationGasLimit, postOpGasLimit *big.Int, data []byte, err error) {
+	if len(paymasterAndData) < PaymasterDataOffset {
+		return common.Address{}, nil, nil, nil, fmt.Errorf("paymasterAndData too short: %d bytes", len(paymasterAndData))
+	}
+	var limits [32]byte
+	copy(limits[:], paymasterAndData[PaymasterValidationGasOffset:PaymasterDataOffset])
+	verificationGasLimit, postOpGasLimit = UnpackUints(limits)
+	return common.BytesToAddress(paymasterAndData[:PaymasterValidationGasOffset]), verificationGasLimit, postOpGasLimit, paymasterAndData[PaymasterDataOffset:], nil
+}
+
+// RequiredGas returns the total gas the EntryPoint reserves for op: the
+// account and paymaster gas limits plus preVerificationGas.
+func (op *PackedUserOperation) RequiredGas() *big.Int {
+	gas := new(big.Int).Add(op.VerificationGasLimit(), op.CallGasLimit())
+	gas.Add(gas, bigOrZero(op.PreVerificationGas))
+	if _, verification, postOp, _, err := UnpackPaymasterAndData(op.PaymasterAndData); err == nil {
+		gas.Add(gas, verification)
+		gas.Add(gas, postOp)
+	}
+	return gas
+}
+
+// RequiredPrefund mirrors EntryPoint._getRequiredPrefund: the maxCost in wei
+// passed to the paymaster.
+func (op *PackedUserOperation) RequiredPrefund() *big.Int {
+	return new(big.Int).Mul(op.RequiredGas(), op.MaxFeePerGas())
+}
+
+// PaymasterVerdict is the predicted outcome of GasPaymaster validation.
+type PaymasterVerdict int
+
+const (
+	// PaymasterOK means the paymaster will sponsor the operation.
+	PaymasterOK PaymasterVerdict = iota
+	// PaymasterPaused means the paymaster is paused.
+	PaymasterPaused
+	// PaymasterInsufficientDeposit means the paymaster EntryPoint deposit does not cover maxCost.
+	PaymasterInsufficientDeposit
+	// PaymasterDailyLimitExceeded means maxCost would exceed the sender's daily limit.
+	PaymasterDailyLimitExceeded
+	// PaymasterInsufficientTokens means the sender's CPOP balance does not cover the token cost.
+	PaymasterInsufficientTokens
+	// PaymasterInsufficientAllowance means the paymaster may not pull the token cost from the sender.
+	PaymasterInsufficientAllowance
+	// PaymasterOracleStale means the operation passes but is priced with the
+	// fallback exchange rate because the oracle price is stale.
+	PaymasterOracleStale
+)
+
+func (v PaymasterVerdict) String() string {
+	switch v {
+	case PaymasterOK:
+		return "ok"
+	case PaymasterPaused:
+		return "paused"
+	case PaymasterInsufficientDeposit:
+		return "insufficient-deposit"
+	case PaymasterDailyLimitExceeded:
+		return "daily-limit-exceeded"
+	case PaymasterInsufficientTokens:
+		return "insufficient-tokens"
+	case PaymasterInsufficientAllowance:
+		return "insufficient-allowance"
+	case PaymasterOracleStale:
+		return "oracle-stale"
+	default:
+		return fmt.Sprintf("PaymasterVerdict(%d)", int(v))
+	}
+}
+
+// Blocking reports whether the verdict makes the EntryPoint reject the operation.
+func (v PaymasterVerdict) Blocking() bool {
+	return v != PaymasterOK && v != PaymasterOracleStale
+}
+
+// PaymasterEstimate is the pre-flight result of PaymasterSponsor.Check.
+type PaymasterEstimate struct {
+	Verdict PaymasterVerdict
+
+	MaxCost   *big.Int // EntryPoint prefund in wei, the gasAmount of canPayForGas
+	TokenCost *big.Int // estimateCost(maxCost), the CPOP charged during validation
+
+	// Detailed is getDetailedGasCostEstimate(requiredGas, maxFeePerGas). Its
+	// fallbackCost is not scaled by 1 ether, so it is informational only.
+	Detailed struct {
+		OracleCost      *big.Int
+		FallbackCost    *big.Int
+		RecommendedCost *big.Int
+		UseOracle       bool
+	}
+
+	Balance    *big.Int // sender CPOP balance
+	Allowance  *big.Int // sender CPOP allowance for the paymaster, nil if not needed
+	DailyLimit *big.Int
+	DailyUsage *big.Int
+	Deposit    *big.Int // paymaster EntryPoint deposit
+
+	OracleHealthy    bool
+	OracleLastUpdate uint64
+}
+
+// DailyRemaining returns the daily limit left before this operation.
+func (e *PaymasterEstimate) DailyRemaining() *big.Int {
+	remaining := new(big.Int).Sub(e.DailyLimit, e.DailyUsage)
+	if remaining.Sign() < 0 {
+		remaining.SetUint64(0)
+	}
+	return remaining
+}
+
+// PaymasterSponsor fills in paymasterAndData for GasPaymaster and predicts
+// whether its validation will pass.
+type PaymasterSponsor struct {
+	address   common.Address
+	paymaster *GasPaymasterCaller
+	token     *CPOPTokenCaller
+
+	VerificationGasLimit *big.Int // paymaster verification gas limit
+	PostOpGasLimit       *big.Int // paymaster postOp gas limit
+}
+
+// NewPaymasterSponsor creates a sponsor for the GasPaymaster at paymaster.
+func NewPaymasterSponsor(opts *bind.CallOpts, backend bind.ContractCaller, paymaster common.Address, verificationGasLimit, postOpGasLimit *big.Int) (*PaymasterSponsor, error) {
+	caller, err := NewGasPaymasterCaller(paymaster, backend)
+	if err != nil {
+		return nil, err
+	}
+	tokenAddr, err := caller.GetToken(opts)
+	if err != nil {
+		return nil, fmt.Errorf("paymaster token: %w", err)
+	}
+	token, err := NewCPOPTokenCaller(tokenAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PaymasterSponsor{
+		address:              paymaster,
+		paymaster:            caller,
+		token:                token,
+		VerificationGasLimit: verificationGasLimit,
+		PostOpGasLimit:       postOpGasLimit,
+	}, nil
+}
+
+// PaymasterAndData returns the paymasterAndData field for the sponsor.
+func (s *PaymasterSponsor) PaymasterAndData() ([]byte, error) {
+	return PackPaymasterAndData(s.address, s.VerificationGasLimit, s.PostOpGasLimit, nil)
+}
+
+// Check predicts the GasPaymaster validation of op, which must already carry
+// the sponsor's paymasterAndData and its final gas fields. Checks run in the
+// order the EntryPoint and paymaster apply them and the first failure decides
+// the verdict.
+func (s *PaymasterSponsor) Check(opts *bind.CallOpts, op *PackedUserOperation) (*PaymasterEstimate, error) {
+	e := &PaymasterEstimate{MaxCost: op.RequiredPrefund()}
+	paused, err := s.paymaster.Paused(opts)
+	if err != nil {
+		return nil, fmt.Errorf("paused: %w", err)
+	}
+	if e.Deposit, err = s.paymaster.GetDeposit(opts); err != nil {
+		return nil, fmt.Errorf("deposit: %w", err)
+	}
+	if e.DailyLimit, err = s.paymaster.GetDailyLimit(opts, op.Sender); err != nil {
+		return nil, fmt.Errorf("daily limit: %w", err)
+	}
+	if e.DailyUsage, err = s.paymaster.GetDailyUsage(opts, op.Sender); err != nil {
+		return nil, fmt.Errorf("daily usage: %w", err)
+	}
+	if e.TokenCost, err = s.paymaster.EstimateCost(opts, e.MaxCost); err != nil {
+		return nil, fmt.Errorf("estimate cost: %w", err)
+	}
+	if e.Detailed, err = s.paymaster.GetDetailedGasCostEstimate(opts, op.RequiredGas(), op.MaxFeePerGas()); err != nil {
+		return nil, fmt.Errorf("detailed gas cost estimate: %w", err)
+	}
+	if e.Balance, err = s.token.BalanceOf(opts, op.Sender); err != nil {
+		return nil, fmt.Errorf("token balance: %w", err)
+	}
+	if needs, err := s.needsAllowance(opts); err != nil {
+		return nil, err
+	} else if needs {
+		if e.Allowance, err = s.token.Allowance(opts, op.Sender, s.address); err != nil {
+			return nil, fmt.Errorf("token allowance: %w", err)
+		}
+	}
+	health, err := s.paymaster.GetOracleHealthStatus(opts)
+	if err != nil {
+		return nil, fmt.Errorf("oracle health: %w", err)
+	}
+	e.OracleHealthy, e.OracleLastUpdate = health.IsHealthy, health.LastUpdate.Uint64()
+
+	switch {
+	case paused:
+		e.Verdict = PaymasterPaused
+	case e.Deposit.Cmp(e.MaxCost) < 0:
+		e.Verdict = PaymasterInsufficientDeposit
+	case new(big.Int).Add(e.DailyUsage, e.MaxCost).Cmp(e.DailyLimit) > 0:
+		e.Verdict = PaymasterDailyLimitExceeded
+	case e.Balance.Cmp(e.TokenCost) < 0:
+		e.Verdict = PaymasterInsufficientTokens
+	case e.Allowance != nil && e.Allowance.Cmp(e.TokenCost) < 0:
+		e.Verdict = PaymasterInsufficientAllowance
+	case !e.OracleHealthy:
+		e.Verdict = PaymasterOracleStale
+	default:
+		e.Verdict = PaymasterOK
+	}
+	return e, nil
+}
+
+// Sponsor sets op's paymasterAndData and checks it. A blocking verdict is
+// returned as an error wrapping ErrPaymasterRejected together with the estimate.
+func (s *PaymasterSponsor) Sponsor(opts *bind.CallOpts, op *PackedUserOperation) (*PaymasterEstimate, error) {
+	paymasterAndData, err := s.PaymasterAndData()
+	if err != nil {
+		return nil, err
+	}
+	op.PaymasterAndData = paymasterAndData
+	e, err := s.Check(opts, op)
+	if err != nil {
+		return nil, err
+	}
+	if e.Verdict.Blocking() {
+		return e, fmt.Errorf("%w: %s", ErrPaymasterRejected, e.Verdict)
+	}
+	return e, nil
+}
+
+// needsAllowance reports whether the paymaster pulls tokens with an
+// allowance: always when transferring, and when burning without BURNER_ROLE.
+func (s *PaymasterSponsor) needsAllowance(opts *bind.CallOpts) (bool, error) {
+	burn, err := s.paymaster.BurnTokens(opts)
+	if err != nil {
+		return false, fmt.Errorf("token handling mode: %w", err)
+	}
+	if !burn {
+		return true, nil
+	}
+	role, err := s.token.BURNERROLE(opts)
+	if err != nil {
+		return false, fmt.Errorf("burner role: %w", err)
+	}
+	burner, err := s.token.HasRole(opts, s.address, role)
+	if err != nil {
+		return false, fmt.Errorf("paymaster burner role: %w", err)
+	}
+	return !burner, nil
+}
@@ -0,0 +1,46 @@
+package cpop_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+func TestPackPaymasterAndData(t *testing.T) {
+	paymaster := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	packed, err := cpop.PackPaymasterAndData(paymaster, big.NewInt(300000), big.NewInt(50000), []byte{0xca, 0xfe})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packed) != cpop.PaymasterDataOffset+2 {
+		t.Fatalf("len = %d, want %d", len(packed), cpop.PaymasterDataOffset+2)
+	}
+	gotPaymaster, verification, postOp, data, err := cpop.UnpackPaymasterAndData(packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPaymaster != paymaster || verification.Int64() != 300000 || postOp.Int64() != 50000 || !bytes.Equal(data, []byte{0xca, 0xfe}) {
+		t.Fatalf("unpacked %s %s %s %x", gotPaymaster.Hex(), verification, postOp, data)
+	}
+
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 128)
+	if _, err := cpop.PackPaymasterAndData(paymaster, tooBig, nil, nil); !errors.Is(err, cpop.ErrUint128Range) {
+		t.Fatalf("err = %v, want ErrUint128Range", err)
+	}
+
+	// Apply leaves the operation untouched when a paymaster limit overflows.
+	op := cpop.PackedUserOperation{PaymasterAndData: packed}
+	estimate := &cpop.UserOpGasEstimate{PaymasterPostOpGasLimit: (*hexutil.Big)(tooBig)}
+	if err := estimate.Apply(&op); !errors.Is(err, cpop.ErrUint128Range) {
+		t.Fatalf("Apply err = %v, want ErrUint128Range", err)
+	}
+	if !bytes.Equal(op.PaymasterAndData, packed) || op.AccountGasLimits != ([32]byte{}) {
+		t.Fatal("Apply modified the operation")
+	}
+}
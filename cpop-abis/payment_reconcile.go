@@ -0,0 +1,366 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultRefundBatchSize is the number of refunds per Payment.batchRefund call
+// used by PaymentReport.RefundBatches when no size is given.
+const DefaultRefundBatchSize = 50
+
+// ErrEmptyRefundBatch is returned when submitting a refund batch without
+// entries, which Payment rejects with "Empty refund array".
+var ErrEmptyRefundBatch = errors.New("empty refund batch")
+
+// PaymentStatus is the reconciliation outcome of an order.
+type PaymentStatus int
+
+const (
+	// PaymentMatched means the order was paid in full with the expected token.
+	PaymentMatched PaymentStatus = iota
+	// PaymentMissing means no payment is recorded for the order.
+	PaymentMissing
+	// PaymentUnderpaid means the order was paid with the expected token but a lower amount.
+	PaymentUnderpaid
+	// PaymentOverpaid means the order was paid with the expected token but a higher amount.
+	PaymentOverpaid
+	// PaymentWrongToken means the order was paid with another token.
+	PaymentWrongToken
+	// PaymentDuplicate means the order or its payment was reported more than once.
+	PaymentDuplicate
+	// PaymentUnexpected means a payment was made for an order that is not expected.
+	PaymentUnexpected
+)
+
+func (s PaymentStatus) String() string {
+	switch s {
+	case PaymentMatched:
+		return "matched"
+	case PaymentMissing:
+		return "missing"
+	case PaymentUnderpaid:
+		return "underpaid"
+	case PaymentOverpaid:
+		return "overpaid"
+	case PaymentWrongToken:
+		return "wrong-token"
+	case PaymentDuplicate:
+		return "duplicate"
+	case PaymentUnexpected:
+		return "unexpected"
+	default:
+		return fmt.Sprintf("PaymentStatus(%d)", int(s))
+	}
+}
+
+// ExpectedOrder is an off-chain order awaiting payment through Payment.pay.
+type ExpectedOrder struct {
+	OrderID *big.Int
+	Token   common.Address // zero address for native currency
+	Amount  *big.Int
+}
+
+// PaymentRefund is one entry of a Payment.batchRefund call.
+type PaymentRefund struct {
+	OrderID *big.Int
+	User    common.Address
+	Token   common.Address
+	Amount  *big.Int
+}
+
+// PaymentMatch is the reconciliation result of one order. Order is nil for
+// unexpected payments and Payment is nil for missing ones.
+type PaymentMatch struct {
+	Status  PaymentStatus
+	Order   *ExpectedOrder
+	Payment *PaymentPaymentInfo
+	Refund  *big.Int // amount to return to the payer, nil if none
+}
+
+// PaymentReport is the outcome of a reconciliation.
+type PaymentReport struct {
+	Matches []PaymentMatch  // ordered by order ID, duplicates after the original
+	Refunds []PaymentRefund // one per order, net of processed refunds
+}
+
+// Issues returns the matches that are not PaymentMatched.
+func (r *PaymentReport) Issues() []PaymentMatch {
+	var issues []PaymentMatch
+	for _, m := range r.Matches {
+		if m.Status != PaymentMatched {
+			issues = append(issues, m)
+		}
+	}
+	return issues
+}
+
+// RefundBatch holds the four parallel arrays of Payment.batchRefund.
+type RefundBatch struct {
+	OrderIDs []*big.Int
+	Users    []common.Address
+	Amounts  []*big.Int
+	Tokens   []common.Address
+}
+
+// Len returns the number of refunds in b.
+func (b *RefundBatch) Len() int {
+	return len(b.OrderIDs)
+}
+
+// Submit sends b to the Payment contract with auth, which must be the owner.
+func (b *RefundBatch) Submit(payment *PaymentTransactor, auth *bind.TransactOpts) (*types.Transaction, error) {
+	if b.Len() == 0 {
+		return nil, ErrEmptyRefundBatch
+	}
+	return payment.BatchRefund(auth, b.OrderIDs, b.Users, b.Amounts, b.Tokens)
+}
+
+// RefundBatches splits the refunds into batches of at most size entries, or
+// DefaultRefundBatchSize if size is not positive.
+func (r *PaymentReport) RefundBatches(size int) []RefundBatch {
+	if size <= 0 {
+		size = DefaultRefundBatchSize
+	}
+	var batches []RefundBatch
+	for start := 0; start < len(r.Refunds); start += size {
+		chunk := r.Refunds[start:min(start+size, len(r.Refunds))]
+		b := RefundBatch{
+			OrderIDs: make([]*big.Int, len(chunk)),
+			Users:    make([]common.Address, len(chunk)),
+			Amounts:  make([]*big.Int, len(chunk)),
+			Tokens:   make([]common.Address, len(chunk)),
+		}
+		for i, refund := range chunk {
+			b.OrderIDs[i], b.Users[i], b.Amounts[i], b.Tokens[i] = refund.OrderID, refund.User, refund.Amount, refund.Token
+		}
+		batches = append(batches, b)
+	}
+	return batches
+}
+
+// PaymentReconciler matches expected orders against Payment records. Orders,
+// payments and processed refunds can be added in any order, e.g. from an
+// order feed and from the PaymentMade and RefundProcessed events.
+type PaymentReconciler struct {
+	// RefundUnderpaid refunds underpaid orders in full instead of only
+	// flagging them.
+	RefundUnderpaid bool
+
+	orders     map[string]*ExpectedOrder
+	orderDups  map[string][]*ExpectedOrder
+	payments   map[string]*PaymentPaymentInfo
+	paymentDup map[string][]*PaymentPaymentInfo
+	refunded   map[string]*big.Int
+}
+
+// NewPaymentReconciler returns an empty reconciler.
+func NewPaymentReconciler() *PaymentReconciler {
+	return &PaymentReconciler{
+		orders:     make(map[string]*ExpectedOrder),
+		orderDups:  make(map[string][]*ExpectedOrder),
+		payments:   make(map[string]*PaymentPaymentInfo),
+		paymentDup: make(map[string][]*PaymentPaymentInfo),
+		refunded:   make(map[string]*big.Int),
+	}
+}
+
+// AddOrder adds an expected order. Later orders with the same ID are reported
+// as PaymentDuplicate.
+func (r *PaymentReconciler) AddOrder(order ExpectedOrder) error {
+	if order.OrderID == nil || order.Amount == nil {
+		return errors.New("order without ID or amount")
+	}
+	key := order.OrderID.String()
+	if _, ok := r.orders[key]; ok {
+		r.orderDups[key] = append(r.orderDups[key], &order)
+		return nil
+	}
+	r.orders[key] = &order
+	return nil
+}
+
+// AddPayment adds an on-chain payment, as returned by Payment.getPayment.
+// Records without a payer, i.e. unpaid orders, are ignored. Identical records
+// are merged, conflicting ones are reported as PaymentDuplicate.
+func (r *PaymentReconciler) AddPayment(p PaymentPaymentInfo) {
+	if p.Payer == (common.Address{}) || p.OrderId == nil {
+		return
+	}
+	key := p.OrderId.String()
+	if prev, ok := r.payments[key]; ok {
+		if !samePayment(prev, &p) {
+			r.paymentDup[key] = append(r.paymentDup[key], &p)
+		}
+		return
+	}
+	r.payments[key] = &p
+}
+
+// AddPaymentEvent adds the payment of a PaymentMade event. An event removed
+// by a reorg takes back the payment it added.
+func (r *PaymentReconciler) AddPaymentEvent(ev *PaymentPaymentMade) {
+	p := PaymentPaymentInfo{OrderId: ev.OrderId, Payer: ev.Payer, Token: ev.Token, Amount: ev.Amount, Timestamp: ev.Timestamp}
+	if ev.Raw.Removed {
+		r.removePayment(&p)
+		return
+	}
+	r.AddPayment(p)
+}
+
+// removePayment drops the payment p of its order. The first conflicting
+// record, if any, takes its place.
+func (r *PaymentReconciler) removePayment(p *PaymentPaymentInfo) {
+	if p.OrderId == nil {
+		return
+	}
+	key := p.OrderId.String()
+	dups := r.paymentDup[key]
+	if prev, ok := r.payments[key]; ok && samePayment(prev, p) {
+		delete(r.payments, key)
+		if len(dups) > 0 {
+			r.payments[key], dups = dups[0], dups[1:]
+		}
+	} else {
+		for i, dup := range dups {
+			if samePayment(dup, p) {
+				dups = append(dups[:i:i], dups[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(dups) == 0 {
+		delete(r.paymentDup, key)
+	} else {
+		r.paymentDup[key] = dups
+	}
+}
+
+// AddRefundEvent records a processed refund, which is deducted from the
+// refund of its order. An event removed by a reorg takes the amount back.
+func (r *PaymentReconciler) AddRefundEvent(ev *PaymentRefundProcessed) {
+	key := ev.OrderId.String()
+	if r.refunded[key] == nil {
+		r.refunded[key] = new(big.Int)
+	}
+	if ev.Raw.Removed {
+		r.refunded[key].Sub(r.refunded[key], ev.Amount)
+	} else {
+		r.refunded[key].Add(r.refunded[key], ev.Amount)
+	}
+	if r.refunded[key].Sign() == 0 {
+		delete(r.refunded, key)
+	}
+}
+
+// Fetch reads the on-chain payment of every expected order that has none yet.
+func (r *PaymentReconciler) Fetch(opts *bind.CallOpts, payment *PaymentCaller) error {
+	for key, order := range r.orders {
+		if _, ok := r.payments[key]; ok {
+			continue
+		}
+		p, err := payment.GetPayment(opts, order.OrderID)
+		if err != nil {
+			return fmt.Errorf("payment of order %s: %w", order.OrderID, err)
+		}
+		r.AddPayment(p)
+	}
+	return nil
+}
+
+// Report reconciles the collected orders and payments.
+func (r *PaymentReconciler) Report() *PaymentReport {
+	keys := make(map[string]*big.Int)
+	for key, o := range r.orders {
+		keys[key] = o.OrderID
+	}
+	for key, p := range r.payments {
+		keys[key] = p.OrderId
+	}
+	ids := make([]*big.Int, 0, len(keys))
+	for _, id := range keys {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+
+	report := new(PaymentReport)
+	for _, id := range ids {
+		key := id.String()
+		m := r.match(r.orders[key], r.payments[key])
+		report.Matches = append(report.Matches, m)
+		for _, dup := range r.orderDups[key] {
+			report.Matches = append(report.Matches, PaymentMatch{Status: PaymentDuplicate, Order: dup, Payment: m.Payment})
+		}
+		for _, dup := range r.paymentDup[key] {
+			report.Matches = append(report.Matches, PaymentMatch{Status: PaymentDuplicate, Order: m.Order, Payment: dup})
+		}
+		if m.Refund == nil {
+			continue
+		}
+		amount := new(big.Int).Set(m.Refund)
+		if done := r.refunded[key]; done != nil {
+			amount.Sub(amount, done)
+		}
+		if amount.Sign() > 0 {
+			report.Refunds = append(report.Refunds, PaymentRefund{OrderID: id, User: m.Payment.Payer, Token: m.Payment.Token, Amount: amount})
+		}
+	}
+	return report
+}
+
+// match reconciles one order with its payment.
+func (r *PaymentReconciler) match(order *ExpectedOrder, payment *PaymentPaymentInfo) PaymentMatch {
+	m := PaymentMatch{Order: order, Payment: payment}
+	switch {
+	case payment == nil:
+		m.Status = PaymentMissing
+	case order == nil:
+		m.Status, m.Refund = PaymentUnexpected, payment.Amount
+	case payment.Token != order.Token:
+		m.Status, m.Refund = PaymentWrongToken, payment.Amount
+	case payment.Amount.Cmp(order.Amount) < 0:
+		m.Status = PaymentUnderpaid
+		if r.RefundUnderpaid {
+			m.Refund = payment.Amount
+		}
+	case payment.Amount.Cmp(order.Amount) > 0:
+		m.Status, m.Refund = PaymentOverpaid, new(big.Int).Sub(payment.Amount, order.Amount)
+	default:
+		m.Status = PaymentMatched
+	}
+	return m
+}
+
+// ReconcilePayments reads expected orders from orders until it is closed,
+// fetches their payments and reconciles them together with any payments and
+// refunds already added to r.
+func (r *PaymentReconciler) ReconcilePayments(ctx context.Context, payment *PaymentCaller, orders <-chan ExpectedOrder) (*PaymentReport, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case order, ok := <-orders:
+			if !ok {
+				if err := r.Fetch(&bind.CallOpts{Context: ctx}, payment); err != nil {
+					return nil, err
+				}
+				return r.Report(), nil
+			}
+			if err := r.AddOrder(order); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// samePayment reports whether a and b describe the same payment.
+func samePayment(a, b *PaymentPaymentInfo) bool {
+	return a.Payer == b.Payer && a.Token == b.Token && bigOrZero(a.Amount).Cmp(bigOrZero(b.Amount)) == 0 && bigOrZero(a.Timestamp).Cmp(bigOrZero(b.Timestamp)) == 0
+}
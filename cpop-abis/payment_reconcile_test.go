@@ -0,0 +1,206 @@
+package cpop_test
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+var (
+	usdtToken = common.HexToAddress("0x00000000000000000000000000000000000000d1")
+	otherCoin = common.HexToAddress("0x00000000000000000000000000000000000000d2")
+	payer     = common.HexToAddress("0x00000000000000000000000000000000000000e1")
+)
+
+func testPayment(order int64, token common.Address, amount int64) cpop.PaymentPaymentInfo {
+	return cpop.PaymentPaymentInfo{OrderId: big.NewInt(order), Payer: payer, Token: token, Amount: big.NewInt(amount), Timestamp: big.NewInt(1000 + order)}
+}
+
+func paymentEvent(p cpop.PaymentPaymentInfo, removed bool) *cpop.PaymentPaymentMade {
+	return &cpop.PaymentPaymentMade{OrderId: p.OrderId, Payer: p.Payer, Token: p.Token, Amount: p.Amount, Timestamp: p.Timestamp, Raw: types.Log{Removed: removed}}
+}
+
+func refundEvent(order, amount int64, removed bool) *cpop.PaymentRefundProcessed {
+	return &cpop.PaymentRefundProcessed{OrderId: big.NewInt(order), User: payer, Amount: big.NewInt(amount), Token: usdtToken, Raw: types.Log{Removed: removed}}
+}
+
+func TestPaymentReconciler(t *testing.T) {
+	order := cpop.ExpectedOrder{OrderID: big.NewInt(1), Token: usdtToken, Amount: big.NewInt(100)}
+	for _, c := range []struct {
+		name            string
+		orders          []cpop.ExpectedOrder
+		payments        []cpop.PaymentPaymentInfo
+		refundUnderpaid bool
+		want            []cpop.PaymentStatus
+		refund          int64 // 0 for none
+	}{
+		{"matched", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 100)}, false, []cpop.PaymentStatus{cpop.PaymentMatched}, 0},
+		{"missing", []cpop.ExpectedOrder{order}, nil, false, []cpop.PaymentStatus{cpop.PaymentMissing}, 0},
+		{"unpaid record", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{{OrderId: big.NewInt(1)}}, false, []cpop.PaymentStatus{cpop.PaymentMissing}, 0},
+		{"underpaid", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 60)}, false, []cpop.PaymentStatus{cpop.PaymentUnderpaid}, 0},
+		{"underpaid refunded", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 60)}, true, []cpop.PaymentStatus{cpop.PaymentUnderpaid}, 60},
+		{"overpaid", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 130)}, false, []cpop.PaymentStatus{cpop.PaymentOverpaid}, 30},
+		{"wrong token", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, otherCoin, 100)}, false, []cpop.PaymentStatus{cpop.PaymentWrongToken}, 100},
+		{"unexpected", nil, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 100)}, false, []cpop.PaymentStatus{cpop.PaymentUnexpected}, 100},
+		{"duplicate order", []cpop.ExpectedOrder{order, order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 100)}, false, []cpop.PaymentStatus{cpop.PaymentMatched, cpop.PaymentDuplicate}, 0},
+		{"identical payments merged", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 100), testPayment(1, usdtToken, 100)}, false, []cpop.PaymentStatus{cpop.PaymentMatched}, 0},
+		{"conflicting payment", []cpop.ExpectedOrder{order}, []cpop.PaymentPaymentInfo{testPayment(1, usdtToken, 100), testPayment(1, usdtToken, 90)}, false, []cpop.PaymentStatus{cpop.PaymentMatched, cpop.PaymentDuplicate}, 0},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := cpop.NewPaymentReconciler()
+			r.RefundUnderpaid = c.refundUnderpaid
+			for _, o := range c.orders {
+				if err := r.AddOrder(o); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, p := range c.payments {
+				r.AddPayment(p)
+			}
+			report := r.Report()
+			var got []cpop.PaymentStatus
+			for _, m := range report.Matches {
+				got = append(got, m.Status)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("statuses %v, want %v", got, c.want)
+			}
+			var refunded int64
+			for _, refund := range report.Refunds {
+				if refund.User != payer || refund.OrderID.Int64() != 1 {
+					t.Errorf("refund %+v", refund)
+				}
+				refunded += refund.Amount.Int64()
+			}
+			if len(report.Refunds) > 1 || refunded != c.refund {
+				t.Fatalf("refunds %+v, want %d", report.Refunds, c.refund)
+			}
+		})
+	}
+	if err := cpop.NewPaymentReconciler().AddOrder(cpop.ExpectedOrder{OrderID: big.NewInt(1)}); err == nil {
+		t.Error("order without amount accepted")
+	}
+}
+
+func TestPaymentReconcilerRefundNetting(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		refunds []*cpop.PaymentRefundProcessed
+		want    int64 // 0 for no refund
+	}{
+		{"none processed", nil, 30},
+		{"partly processed", []*cpop.PaymentRefundProcessed{refundEvent(1, 10, false)}, 20},
+		{"fully processed", []*cpop.PaymentRefundProcessed{refundEvent(1, 10, false), refundEvent(1, 20, false)}, 0},
+		{"over processed", []*cpop.PaymentRefundProcessed{refundEvent(1, 50, false)}, 0},
+		{"removed by reorg", []*cpop.PaymentRefundProcessed{refundEvent(1, 30, false), refundEvent(1, 30, true)}, 30},
+		{"other order", []*cpop.PaymentRefundProcessed{refundEvent(2, 30, false)}, 30},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := cpop.NewPaymentReconciler()
+			if err := r.AddOrder(cpop.ExpectedOrder{OrderID: big.NewInt(1), Token: usdtToken, Amount: big.NewInt(100)}); err != nil {
+				t.Fatal(err)
+			}
+			r.AddPaymentEvent(paymentEvent(testPayment(1, usdtToken, 130), false))
+			for _, ev := range c.refunds {
+				r.AddRefundEvent(ev)
+			}
+			report := r.Report()
+			if c.want == 0 {
+				if len(report.Refunds) != 0 {
+					t.Fatalf("refunds %+v, want none", report.Refunds)
+				}
+				return
+			}
+			if len(report.Refunds) != 1 || report.Refunds[0].Amount.Int64() != c.want || report.Refunds[0].Token != usdtToken {
+				t.Fatalf("refunds %+v, want %d", report.Refunds, c.want)
+			}
+		})
+	}
+}
+
+func TestPaymentReconcilerRemovedPayment(t *testing.T) {
+	order := cpop.ExpectedOrder{OrderID: big.NewInt(1), Token: usdtToken, Amount: big.NewInt(100)}
+	paid, conflict := testPayment(1, usdtToken, 100), testPayment(1, usdtToken, 90)
+	for _, c := range []struct {
+		name   string
+		events []*cpop.PaymentPaymentMade
+		want   []cpop.PaymentStatus
+	}{
+		{"removed", []*cpop.PaymentPaymentMade{paymentEvent(paid, false), paymentEvent(paid, true)}, []cpop.PaymentStatus{cpop.PaymentMissing}},
+		{"replayed", []*cpop.PaymentPaymentMade{paymentEvent(paid, false), paymentEvent(paid, true), paymentEvent(paid, false)}, []cpop.PaymentStatus{cpop.PaymentMatched}},
+		{"conflict takes its place", []*cpop.PaymentPaymentMade{paymentEvent(paid, false), paymentEvent(conflict, false), paymentEvent(paid, true)}, []cpop.PaymentStatus{cpop.PaymentUnderpaid}},
+		{"conflict removed", []*cpop.PaymentPaymentMade{paymentEvent(paid, false), paymentEvent(conflict, false), paymentEvent(conflict, true)}, []cpop.PaymentStatus{cpop.PaymentMatched}},
+		{"unknown removal", []*cpop.PaymentPaymentMade{paymentEvent(paid, false), paymentEvent(testPayment(1, otherCoin, 100), true)}, []cpop.PaymentStatus{cpop.PaymentMatched}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r := cpop.NewPaymentReconciler()
+			if err := r.AddOrder(order); err != nil {
+				t.Fatal(err)
+			}
+			for _, ev := range c.events {
+				r.AddPaymentEvent(ev)
+			}
+			var got []cpop.PaymentStatus
+			for _, m := range r.Report().Matches {
+				got = append(got, m.Status)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("statuses %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestRefundBatches(t *testing.T) {
+	report := new(cpop.PaymentReport)
+	for i := range 7 {
+		report.Refunds = append(report.Refunds, cpop.PaymentRefund{
+			OrderID: big.NewInt(int64(i + 1)),
+			User:    common.BigToAddress(big.NewInt(int64(0xe0 + i))),
+			Token:   usdtToken,
+			Amount:  big.NewInt(int64(10 * (i + 1))),
+		})
+	}
+	for _, c := range []struct {
+		size int
+		want []int
+	}{
+		{3, []int{3, 3, 1}},
+		{7, []int{7}},
+		{10, []int{7}},
+		{0, []int{7}}, // DefaultRefundBatchSize
+		{1, []int{1, 1, 1, 1, 1, 1, 1}},
+	} {
+		batches := report.RefundBatches(c.size)
+		var lens []int
+		next := 0
+		for _, b := range batches {
+			if len(b.Users) != b.Len() || len(b.Amounts) != b.Len() || len(b.Tokens) != b.Len() {
+				t.Fatalf("size %d: array lengths %d %d %d %d", c.size, len(b.OrderIDs), len(b.Users), len(b.Amounts), len(b.Tokens))
+			}
+			for i := range b.Len() {
+				want := report.Refunds[next]
+				if b.OrderIDs[i] != want.OrderID || b.Users[i] != want.User || b.Amounts[i] != want.Amount || b.Tokens[i] != want.Token {
+					t.Errorf("size %d: refund %d = %s %s %s %s, want %+v", c.size, next, b.OrderIDs[i], b.Users[i].Hex(), b.Amounts[i], b.Tokens[i].Hex(), want)
+				}
+				next++
+			}
+			lens = append(lens, b.Len())
+		}
+		if !reflect.DeepEqual(lens, c.want) || next != len(report.Refunds) {
+			t.Errorf("size %d: batches of %v, want %v", c.size, lens, c.want)
+		}
+	}
+	if batches := new(cpop.PaymentReport).RefundBatches(5); len(batches) != 0 {
+		t.Errorf("%d batches without refunds", len(batches))
+	}
+	var empty cpop.RefundBatch
+	if _, err := empty.Submit(nil, nil); err != cpop.ErrEmptyRefundBatch {
+		t.Errorf("empty batch: %v", err)
+	}
+}
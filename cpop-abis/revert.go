@@ -0,0 +1,243 @@
+package cpop
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errorStringSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector       = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+
+	// reasonPrefix matches the "Contract: message" convention of the CPP requires.
+	reasonPrefix = regexp.MustCompile(`^([A-Z][A-Za-z0-9]*): (.*)$`)
+)
+
+// RevertArg is a decoded custom error argument.
+type RevertArg struct {
+	Name  string
+	Type  string
+	Value interface{}
+}
+
+// RevertError is a decoded contract revert.
+type RevertError struct {
+	// Contract is the contract the revert is attributed to: the prefix of a
+	// "Contract: message" require, or the only package ABI declaring the
+	// custom error. It is empty when unknown.
+	Contract string
+	// Candidates lists every package contract whose ABI declares the custom error.
+	Candidates []string
+	// Name is "Error" for string reverts, "Panic" for panics, or the custom error name.
+	Name string
+	// Reason is the require message without its contract prefix, the panic
+	// description, or the reason argument of a custom error such as FailedOp.
+	Reason string
+	// Args are the custom error arguments.
+	Args []RevertArg
+	// Inner is the nested revert carried by errors like FailedOpWithRevert
+	// and PostOpReverted.
+	Inner *RevertError
+	// Data is the raw revert data.
+	Data []byte
+
+	err error
+}
+
+func (e *RevertError) Error() string {
+	var msg string
+	switch e.Name {
+	case "Error":
+		msg = e.Reason
+	case "Panic":
+		msg = "panic: " + e.Reason
+	default:
+		parts := make([]string, 0, len(e.Args))
+		for _, a := range e.Args {
+			if _, ok := a.Value.([]byte); ok && e.Inner != nil {
+				continue
+			}
+			parts = append(parts, fmt.Sprintf("%s=%v", a.Name, a.Value))
+		}
+		msg = fmt.Sprintf("%s(%s)", e.Name, strings.Join(parts, ", "))
+	}
+	if e.Contract != "" {
+		msg = e.Contract + ": " + msg
+	}
+	if e.Inner != nil {
+		msg += ": " + e.Inner.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the error the revert was decoded from, if any.
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// Arg returns the value of the named custom error argument.
+func (e *RevertError) Arg(name string) (interface{}, bool) {
+	for _, a := range e.Args {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Root returns the innermost nested revert.
+func (e *RevertError) Root() *RevertError {
+	for e.Inner != nil {
+		e = e.Inner
+	}
+	return e
+}
+
+// DecodeRevert decodes the revert data carried by err, as returned by the
+// binding Caller and Transactor methods, gas estimation or a bundler. It
+// returns false when err carries no revert data.
+func DecodeRevert(err error) (*RevertError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var revert *RevertError
+	if errors.As(err, &revert) {
+		return revert, true
+	}
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	var data []byte
+	switch d := dataErr.ErrorData().(type) {
+	case string:
+		raw, decodeErr := hexutil.Decode(d)
+		if decodeErr != nil {
+			return nil, false
+		}
+		data = raw
+	case []byte:
+		data = d
+	case hexutil.Bytes:
+		data = d
+	default:
+		return nil, false
+	}
+	revert, ok := DecodeRevertData(data)
+	if ok {
+		revert.err = err
+	}
+	return revert, ok
+}
+
+// AsRevertError returns err as a *RevertError when it carries revert data,
+// and err unchanged otherwise.
+func AsRevertError(err error) error {
+	if revert, ok := DecodeRevert(err); ok {
+		return revert
+	}
+	return err
+}
+
+// DecodeRevertData decodes raw revert data: Error(string), Panic(uint256) or
+// a custom error declared by any package ABI. It returns false for data that
+// matches none of them.
+func DecodeRevertData(data []byte) (*RevertError, bool) {
+	if len(data) < 4 {
+		return nil, false
+	}
+	switch {
+	case bytes.Equal(data[:4], errorStringSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return nil, false
+		}
+		e := &RevertError{Name: "Error", Reason: reason, Data: data}
+		if m := reasonPrefix.FindStringSubmatch(reason); m != nil {
+			e.Contract, e.Reason = m[1], m[2]
+		}
+		return e, true
+	case bytes.Equal(data[:4], panicSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return nil, false
+		}
+		return &RevertError{Name: "Panic", Reason: reason, Data: data}, true
+	}
+
+	var (
+		abiErr     *abi.Error
+		candidates []string
+	)
+	for _, name := range sortedContractNames() {
+		parsed, err := contractMetaData[name].GetAbi()
+		if err != nil {
+			continue
+		}
+		if found, err := parsed.ErrorByID([4]byte(data[:4])); err == nil {
+			abiErr = found
+			candidates = append(candidates, name)
+		}
+	}
+	if abiErr == nil {
+		return nil, false
+	}
+	unpacked, err := abiErr.Unpack(data)
+	if err != nil {
+		return nil, false
+	}
+	values, _ := unpacked.([]interface{})
+	e := &RevertError{Candidates: candidates, Name: abiErr.Name, Data: data}
+	if len(candidates) == 1 {
+		e.Contract = candidates[0]
+	}
+	for i, input := range abiErr.Inputs {
+		if i >= len(values) {
+			break
+		}
+		arg := RevertArg{Name: input.Name, Type: input.Type.String(), Value: values[i]}
+		e.Args = append(e.Args, arg)
+		switch v := arg.Value.(type) {
+		case string:
+			if input.Name == "reason" {
+				e.Reason = v
+			}
+		case []byte:
+			// Nested revert data, as in FailedOpWithRevert and PostOpReverted.
+			if inner, ok := DecodeRevertData(v); ok && e.Inner == nil {
+				e.Inner = inner
+			}
+		}
+	}
+	return e, true
+}
+
+// DecodeRevertReason renders revert data as text, falling back to hex for
+// data no package ABI can decode.
+func DecodeRevertReason(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if revert, ok := DecodeRevertData(data); ok {
+		return revert.Error()
+	}
+	return hexutil.Encode(data)
+}
+
+// sortedContractNames returns the package contract names in a stable order.
+func sortedContractNames() []string {
+	names := make([]string, 0, len(contractMetaData))
+	for name := range contractMetaData {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
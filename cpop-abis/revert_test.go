@@ -0,0 +1,285 @@
+package cpop_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// Revert payloads as returned by the node, one ABI word per line.
+var (
+	// Error("Marketplace: Listing not active")
+	revertPrefixed = "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000001f" +
+		"4d61726b6574706c6163653a204c697374696e67206e6f742061637469766500"
+	// Error("Bid amount too low")
+	revertPlain = "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000012" +
+		"42696420616d6f756e7420746f6f206c6f770000000000000000000000000000"
+	// Error("Invalid level: must be C or above")
+	revertColon = "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000021" +
+		"496e76616c6964206c6576656c3a206d7573742062652043206f722061626f76" +
+		"6500000000000000000000000000000000000000000000000000000000000000"
+	// Panic(0x11)
+	revertOverflow = "0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"
+	// ERC721NonexistentToken(7), declared by CPNFT only.
+	revertNonexistent = "0x7e273289" +
+		"0000000000000000000000000000000000000000000000000000000000000007"
+	// OwnableUnauthorizedAccount(0xaa), declared by every Ownable contract.
+	revertUnauthorized = "0x118cdaa7" +
+		"00000000000000000000000000000000000000000000000000000000000000aa"
+	// EnforcedPause()
+	revertPaused = "0xd93c0665"
+	// FailedOp(0, "AA21 didn't pay prefund")
+	revertFailedOp = "0x220266b6" +
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000017" +
+		"41413231206469646e2774207061792070726566756e64000000000000000000"
+	// FailedOpWithRevert(1, "AA23 reverted",
+	//   ExecuteError(2, Error("Staking: Not the owner of this NFT")))
+	revertNested = "0x65c8fd4d" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000060" +
+		"00000000000000000000000000000000000000000000000000000000000000a0" +
+		"000000000000000000000000000000000000000000000000000000000000000d" +
+		"4141323320726576657274656400000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000104" +
+		"5a154675" + // ExecuteError
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"0000000000000000000000000000000000000000000000000000000000000040" +
+		"0000000000000000000000000000000000000000000000000000000000000084" +
+		"08c379a0" + // Error
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000022" +
+		"5374616b696e673a204e6f7420746865206f776e6572206f662074686973204e" +
+		"4654000000000000000000000000000000000000000000000000000000000000" +
+		"00000000000000000000000000000000000000000000000000000000" +
+		"00000000000000000000000000000000000000000000000000000000"
+	// PostOpReverted(Panic(0x01))
+	revertPostOp = "0xad7954bc" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000024" +
+		"4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		"00000000000000000000000000000000000000000000000000000000"
+	// PostOpReverted(0xdeadbeef)
+	revertPostOpUnknown = "0xad7954bc" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"deadbeef00000000000000000000000000000000000000000000000000000000"
+)
+
+func TestDecodeRevertData(t *testing.T) {
+	ownable := []string{
+		"AccountManager", "CPNFT", "ChapoolEarnVault", "ChapoolRewardDistributor", "ChapoolVaultReader",
+		"GasPaymaster", "GasPriceOracle", "Marketplace", "MasterAggregator", "MockUSDT", "NFTBoostController",
+		"SessionKeyManager", "Staking", "StakingConfig", "StakingReader", "VeCPOTLocker",
+	}
+	pausable := []string{"ChapoolEarnVault", "GasPaymaster", "GasPriceOracle", "Marketplace", "Staking"}
+	for _, c := range []struct {
+		name       string
+		data       string
+		contract   string
+		candidates []string
+		errName    string
+		reason     string
+		args       map[string]interface{}
+		msg        string
+	}{
+		{
+			name: "prefixed require", data: revertPrefixed,
+			contract: "Marketplace", errName: "Error", reason: "Listing not active",
+			msg: "Marketplace: Listing not active",
+		},
+		{
+			name: "plain require", data: revertPlain,
+			errName: "Error", reason: "Bid amount too low",
+			msg: "Bid amount too low",
+		},
+		{
+			// Only a contract name is taken as a prefix.
+			name: "colon in message", data: revertColon,
+			errName: "Error", reason: "Invalid level: must be C or above",
+			msg: "Invalid level: must be C or above",
+		},
+		{
+			name: "panic", data: revertOverflow,
+			errName: "Panic", reason: "arithmetic underflow or overflow",
+			msg: "panic: arithmetic underflow or overflow",
+		},
+		{
+			name: "custom error", data: revertNonexistent,
+			contract: "CPNFT", candidates: []string{"CPNFT"}, errName: "ERC721NonexistentToken",
+			args: map[string]interface{}{"tokenId": big.NewInt(7)},
+			msg:  "CPNFT: ERC721NonexistentToken(tokenId=7)",
+		},
+		{
+			name: "ambiguous custom error", data: revertUnauthorized,
+			candidates: ownable, errName: "OwnableUnauthorizedAccount",
+			args: map[string]interface{}{"account": common.HexToAddress("0xaa")},
+			msg:  fmt.Sprintf("OwnableUnauthorizedAccount(account=%s)", common.HexToAddress("0xaa")),
+		},
+		{
+			name: "ambiguous custom error without arguments", data: revertPaused,
+			candidates: pausable, errName: "EnforcedPause",
+			msg: "EnforcedPause()",
+		},
+		{
+			name: "reason argument", data: revertFailedOp,
+			contract: "EntryPoint", candidates: []string{"EntryPoint"}, errName: "FailedOp", reason: "AA21 didn't pay prefund",
+			args: map[string]interface{}{"opIndex": big.NewInt(0), "reason": "AA21 didn't pay prefund"},
+			msg:  "EntryPoint: FailedOp(opIndex=0, reason=AA21 didn't pay prefund)",
+		},
+		{
+			name: "nested revert", data: revertNested,
+			contract: "EntryPoint", candidates: []string{"EntryPoint"}, errName: "FailedOpWithRevert", reason: "AA23 reverted",
+			args: map[string]interface{}{"opIndex": big.NewInt(1), "reason": "AA23 reverted"},
+			msg:  "EntryPoint: FailedOpWithRevert(opIndex=1, reason=AA23 reverted): AAccount: ExecuteError(index=2): Staking: Not the owner of this NFT",
+		},
+		{
+			name: "nested panic", data: revertPostOp,
+			contract: "EntryPoint", candidates: []string{"EntryPoint"}, errName: "PostOpReverted",
+			msg: "EntryPoint: PostOpReverted(): panic: assert(false)",
+		},
+		{
+			// Nested data that does not decode is kept as an argument.
+			name: "unknown nested data", data: revertPostOpUnknown,
+			contract: "EntryPoint", candidates: []string{"EntryPoint"}, errName: "PostOpReverted",
+			args: map[string]interface{}{"returnData": []byte{0xde, 0xad, 0xbe, 0xef}},
+			msg:  "EntryPoint: PostOpReverted(returnData=[222 173 190 239])",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			data := hexutil.MustDecode(c.data)
+			e, ok := cpop.DecodeRevertData(data)
+			if !ok {
+				t.Fatal("not decoded")
+			}
+			if e.Contract != c.contract || e.Name != c.errName || e.Reason != c.reason {
+				t.Errorf("decoded %s %s %q, want %s %s %q", e.Contract, e.Name, e.Reason, c.contract, c.errName, c.reason)
+			}
+			if !reflect.DeepEqual(e.Candidates, c.candidates) {
+				t.Errorf("candidates %v, want %v", e.Candidates, c.candidates)
+			}
+			for name, want := range c.args {
+				got, ok := e.Arg(name)
+				if n, isInt := want.(*big.Int); isInt {
+					ok = ok && n.Cmp(got.(*big.Int)) == 0
+				} else {
+					ok = ok && reflect.DeepEqual(got, want)
+				}
+				if !ok {
+					t.Errorf("argument %s = %v, want %v", name, got, want)
+				}
+			}
+			if got := e.Error(); got != c.msg {
+				t.Errorf("Error() = %q, want %q", got, c.msg)
+			}
+			if got := cpop.DecodeRevertReason(data); got != c.msg {
+				t.Errorf("DecodeRevertReason = %q, want %q", got, c.msg)
+			}
+			if !bytes.Equal(e.Data, data) {
+				t.Errorf("data %x, want %x", e.Data, data)
+			}
+		})
+	}
+
+	// Every level of a nested revert is decoded, down to the require.
+	e, _ := cpop.DecodeRevertData(hexutil.MustDecode(revertNested))
+	var names []string
+	for r := e; r != nil; r = r.Inner {
+		names = append(names, r.Contract+"."+r.Name)
+	}
+	if want := []string{"EntryPoint.FailedOpWithRevert", "AAccount.ExecuteError", "Staking.Error"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("nested reverts %v, want %v", names, want)
+	}
+	if root := e.Root(); root.Reason != "Not the owner of this NFT" {
+		t.Errorf("root reason %q", root.Reason)
+	}
+	if index, _ := e.Inner.Arg("index"); index.(*big.Int).Int64() != 2 {
+		t.Errorf("inner index %v", index)
+	}
+
+	for _, data := range []string{
+		"0x",
+		"0x08c379a0",           // selector only
+		revertPlain[:2+4*2+64], // truncated string
+		"0xdeadbeef",           // declared by no package ABI
+		revertNonexistent[:20], // truncated argument
+	} {
+		if e, ok := cpop.DecodeRevertData(hexutil.MustDecode(data)); ok {
+			t.Errorf("%s decoded as %v", data, e)
+		}
+	}
+	if got := cpop.DecodeRevertReason(hexutil.MustDecode("0xdeadbeef")); got != "0xdeadbeef" {
+		t.Errorf("DecodeRevertReason of unknown data = %q", got)
+	}
+	if got := cpop.DecodeRevertReason(nil); got != "" {
+		t.Errorf("DecodeRevertReason of no data = %q", got)
+	}
+}
+
+func TestDecodeRevert(t *testing.T) {
+	data := hexutil.MustDecode(revertPrefixed)
+	decoded, _ := cpop.DecodeRevertData(data)
+	for _, c := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "hex string", err: &fakeBundlerError{data: revertPrefixed}, want: true},
+		{name: "bytes", err: &fakeBundlerError{data: data}, want: true},
+		{name: "hexutil.Bytes", err: &fakeBundlerError{data: hexutil.Bytes(data)}, want: true},
+		{name: "wrapped", err: fmt.Errorf("estimate gas: %w", &fakeBundlerError{data: revertPrefixed}), want: true},
+		{name: "decoded", err: fmt.Errorf("send: %w", decoded), want: true},
+		{name: "nil", err: nil},
+		{name: "no data error", err: errors.New("execution reverted")},
+		{name: "bad hex", err: &fakeBundlerError{data: "0xzz"}},
+		{name: "other data", err: &fakeBundlerError{data: 42}},
+		{name: "unknown data", err: &fakeBundlerError{data: "0xdeadbeef"}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			e, ok := cpop.DecodeRevert(c.err)
+			if ok != c.want {
+				t.Fatalf("DecodeRevert = %v, %v; want ok %v", e, ok, c.want)
+			}
+			as := cpop.AsRevertError(c.err)
+			if !ok {
+				if as != c.err {
+					t.Errorf("AsRevertError = %v, want the error unchanged", as)
+				}
+				return
+			}
+			if e.Error() != "Marketplace: Listing not active" {
+				t.Errorf("Error() = %q", e.Error())
+			}
+			if revert, isRevert := as.(*cpop.RevertError); !isRevert || revert.Error() != e.Error() {
+				t.Errorf("AsRevertError = %#v, want %v", as, e)
+			}
+			// A decoded revert is passed on as is; a fresh one unwraps to
+			// the node error it was decoded from.
+			var rpcErr *fakeBundlerError
+			if c.name == "decoded" {
+				if e != decoded {
+					t.Errorf("DecodeRevert decoded %v again", decoded)
+				}
+			} else if !errors.As(as, &rpcErr) {
+				t.Errorf("%v does not unwrap to the node error", as)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package cpop
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SessionPermissionKind describes what a session key permission restricts.
+type SessionPermissionKind int
+
+const (
+	// PermissionAllowAll is the zero permission: every target and selector.
+	PermissionAllowAll SessionPermissionKind = iota
+	// PermissionTargetOnly allows any selector on a single target.
+	PermissionTargetOnly
+	// PermissionSelectorOnly allows a single selector on any target.
+	PermissionSelectorOnly
+	// PermissionTargetAndSelector allows a single selector on a single target.
+	PermissionTargetAndSelector
+)
+
+func (k SessionPermissionKind) String() string {
+	switch k {
+	case PermissionAllowAll:
+		return "allow-all"
+	case PermissionTargetOnly:
+		return "target-only"
+	case PermissionSelectorOnly:
+		return "selector-only"
+	case PermissionTargetAndSelector:
+		return "target-and-selector"
+	default:
+		return fmt.Sprintf("SessionPermissionKind(%d)", int(k))
+	}
+}
+
+// SessionPermission is the bytes32 permissions value checked by
+// AAccount._checkPermissions, laid out as
+// [targetHash(16)][selector(4)][flags(12)] where targetHash is
+// bytes16(keccak256(abi.encode(target))). A zero half matches anything.
+type SessionPermission [32]byte
+
+// NewSessionPermission builds a permission for target and selector. A zero
+// target or selector leaves that half unrestricted.
+func NewSessionPermission(target common.Address, selector [4]byte) SessionPermission {
+	var p SessionPermission
+	if target != (common.Address{}) {
+		copy(p[:16], SessionTargetHash(target))
+	}
+	copy(p[16:20], selector[:])
+	return p
+}
+
+// NewSessionPermissionForMethod builds a permission for target and the
+// selector of method, resolved with LookupSelector.
+func NewSessionPermissionForMethod(target common.Address, method string) (SessionPermission, error) {
+	selector, err := LookupSelector(method)
+	if err != nil {
+		return SessionPermission{}, err
+	}
+	return NewSessionPermission(target, selector), nil
+}
+
+// SessionTargetHash returns bytes16(keccak256(abi.encode(target))).
+func SessionTargetHash(target common.Address) []byte {
+	return crypto.Keccak256(common.LeftPadBytes(target.Bytes(), 32))[:16]
+}
+
+// Bytes32 returns the value to pass as permissions to the contracts.
+func (p SessionPermission) Bytes32() [32]byte {
+	return p
+}
+
+// TargetHash returns the 16 byte target hash half.
+func (p SessionPermission) TargetHash() [16]byte {
+	var h [16]byte
+	copy(h[:], p[:16])
+	return h
+}
+
+// Selector returns the 4 byte function selector.
+func (p SessionPermission) Selector() [4]byte {
+	var s [4]byte
+	copy(s[:], p[16:20])
+	return s
+}
+
+// Flags returns the trailing 12 flag bytes, currently ignored by AAccount.
+func (p SessionPermission) Flags() [12]byte {
+	var f [12]byte
+	copy(f[:], p[20:])
+	return f
+}
+
+// Kind classifies the permission.
+func (p SessionPermission) Kind() SessionPermissionKind {
+	anyTarget := p.TargetHash() == [16]byte{}
+	anySelector := p.Selector() == [4]byte{}
+	switch {
+	case p == SessionPermission{}:
+		return PermissionAllowAll
+	case anyTarget && anySelector:
+		// Only flags are set, which _checkPermissions treats as unrestricted.
+		return PermissionAllowAll
+	case anySelector:
+		return PermissionTargetOnly
+	case anyTarget:
+		return PermissionSelectorOnly
+	default:
+		return PermissionTargetAndSelector
+	}
+}
+
+// Allows mirrors AAccount._checkPermissions for target and selector.
+func (p SessionPermission) Allows(target common.Address, selector [4]byte) bool {
+	if p == (SessionPermission{}) {
+		return true
+	}
+	targetHash := p.TargetHash()
+	targetMatches := targetHash == [16]byte{} || bytes.Equal(targetHash[:], SessionTargetHash(target))
+	sel := p.Selector()
+	selectorMatches := sel == [4]byte{} || sel == selector
+	return targetMatches && selectorMatches
+}
+
+// Explain describes the permission in words. The target hash is one-way, so
+// candidates are matched against it to name the allowed target when possible.
+func (p SessionPermission) Explain(candidates ...common.Address) string {
+	target := "any target"
+	if h := p.TargetHash(); h != [16]byte{} {
+		target = fmt.Sprintf("target hash 0x%x", h[:])
+		for _, c := range candidates {
+			if bytes.Equal(h[:], SessionTargetHash(c)) {
+				target = "target " + c.Hex()
+				break
+			}
+		}
+	}
+	method := "any method"
+	if sel := p.Selector(); sel != [4]byte{} {
+		method = fmt.Sprintf("selector 0x%x", sel[:])
+		if names := selectorNames(sel); len(names) > 0 {
+			method += " (" + strings.Join(names, ", ") + ")"
+		}
+	}
+	return fmt.Sprintf("%s: %s on %s", p.Kind(), method, target)
+}
+
+// String returns the 0x-prefixed hex encoding.
+func (p SessionPermission) String() string {
+	return hexutil.Encode(p[:])
+}
+
+// SessionKey mirrors AAccount's SessionKeyData.
+type SessionKey struct {
+	ValidAfter  uint64
+	ValidUntil  uint64
+	Permissions SessionPermission
+	IsActive    bool
+}
+
+// NewSessionKey converts the output of AAccount.SessionKeys.
+func NewSessionKey(validAfter, validUntil *big.Int, permissions [32]byte, isActive bool) SessionKey {
+	return SessionKey{
+		ValidAfter:  validAfter.Uint64(),
+		ValidUntil:  validUntil.Uint64(),
+		Permissions: permissions,
+		IsActive:    isActive,
+	}
+}
+
+// ValidAt reports whether the key is active and inside its validity window at
+// the given block timestamp.
+func (k SessionKey) ValidAt(timestamp uint64) bool {
+	return k.IsActive && timestamp >= k.ValidAfter && timestamp <= k.ValidUntil
+}
+
+// CanExecute predicts AAccount.canSessionKeyExecute at the given block timestamp.
+func (k SessionKey) CanExecute(target common.Address, selector [4]byte, timestamp uint64) bool {
+	return k.ValidAt(timestamp) && k.Permissions.Allows(target, selector)
+}
+
+// LookupSelector resolves a method to its selector. It accepts a full
+// signature ("transfer(address,uint256)"), a qualified name
+// ("CPOPToken.transfer") or a bare name that must resolve to a single
+// selector across the package ABIs.
+func LookupSelector(method string) ([4]byte, error) {
+	var selector [4]byte
+	if strings.Contains(method, "(") {
+		copy(selector[:], crypto.Keccak256([]byte(method))[:4])
+		return selector, nil
+	}
+	contract, name, qualified := strings.Cut(method, ".")
+	if !qualified {
+		name = method
+	}
+
+	found := map[[4]byte]string{}
+	for contractName, meta := range contractMetaData {
+		if qualified && contractName != contract {
+			continue
+		}
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			return selector, err
+		}
+		for _, m := range parsed.Methods {
+			if m.RawName == name || m.Name == name {
+				copy(selector[:], m.ID)
+				found[selector] = m.Sig
+			}
+		}
+	}
+	switch len(found) {
+	case 0:
+		return [4]byte{}, fmt.Errorf("method %q not found in package ABIs", method)
+	case 1:
+		for s := range found {
+			return s, nil
+		}
+	}
+	sigs := make([]string, 0, len(found))
+	for _, sig := range found {
+		sigs = append(sigs, sig)
+	}
+	sort.Strings(sigs)
+	return [4]byte{}, fmt.Errorf("method %q is ambiguous: %s", method, strings.Join(sigs, ", "))
+}
+
+// selectorNames returns the signatures matching selector in the package ABIs.
+func selectorNames(selector [4]byte) []string {
+	seen := map[string]bool{}
+	for _, meta := range contractMetaData {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			continue
+		}
+		if m, err := parsed.MethodById(selector[:]); err == nil {
+			seen[m.Sig] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for sig := range seen {
+		names = append(names, sig)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,173 @@
+package cpop_test
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+var (
+	permissionTarget = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	transferSelector = [4]byte{0xa9, 0x05, 0x9c, 0xbb} // transfer(address,uint256)
+)
+
+// sessionFixture is an AAccount whose master signer, the core deployer, lets
+// the core SessionKeyManager add session keys to it.
+type sessionFixture struct {
+	t       *testing.T
+	sim     *simulated.Backend
+	core    *cpoptest.Core
+	auth    *bind.TransactOpts
+	account common.Address
+	aa      *cpop.AAccount
+}
+
+func newSessionFixture(t *testing.T) *sessionFixture {
+	t.Helper()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	t.Cleanup(func() { sim.Close() })
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &sessionFixture{t: t, sim: sim, core: core, auth: auth}
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	f.mined(core.AccountManager.CreateAccount(auth, owner, common.Address{}))
+	if f.account, err = core.AccountManager.GetAccountAddress(nil, owner, common.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	if f.aa, err = cpop.NewAAccount(f.account, sim.Client()); err != nil {
+		t.Fatal(err)
+	}
+	f.mined(f.aa.SetAuthorizedSessionKeyManager(auth, core.Addresses.SessionKeyManager))
+	f.mined(core.SessionKeyManager.RegisterAccount(auth, auth.From, f.account))
+	return f
+}
+
+func (f *sessionFixture) mined(tx interface{ Hash() common.Hash }, err error) {
+	f.t.Helper()
+	if err != nil {
+		f.t.Fatal(cpop.AsRevertError(err))
+	}
+	f.sim.Commit()
+}
+
+// addSessionKey adds a session key with permissions to the account through a
+// SessionKeyManager template, and returns it with the data the account stored.
+func (f *sessionFixture) addSessionKey(t *testing.T, permissions cpop.SessionPermission) (*ecdsa.PrivateKey, cpop.SessionKey) {
+	t.Helper()
+	key, _ := crypto.GenerateKey()
+	session := crypto.PubkeyToAddress(key.PublicKey)
+	name := "template-" + session.Hex()
+	skm := f.core.SessionKeyManager
+	f.mined(skm.CreateSessionKeyTemplate(f.auth, name, big.NewInt(3600), permissions.Bytes32()))
+	template, err := skm.GetTemplate(nil, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cpop.SessionPermission(template.Permissions); got != permissions {
+		t.Fatalf("template permissions %s, want %s", got, permissions)
+	}
+	// The manager swallows failures of addSessionKey, so a gas estimate
+	// just large enough for the loop would leave the account unchanged.
+	add := *f.auth
+	add.GasLimit = 1_000_000
+	f.mined(skm.AddSessionKeyWithTemplate(&add, f.auth.From, session, name, big.NewInt(0)))
+	signer, err := cpop.LoadSessionKeySigner(nil, f.sim.Client(), f.account, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := signer.Session()
+	if !stored.IsActive || stored.Permissions != permissions {
+		t.Fatalf("account session key %+v, want permissions %s", stored, permissions)
+	}
+	return key, stored
+}
+
+func TestSessionPermissionLayout(t *testing.T) {
+	for _, c := range []struct {
+		target   common.Address
+		selector [4]byte
+		want     string
+		kind     cpop.SessionPermissionKind
+	}{
+		{permissionTarget, transferSelector, "0x550d3de95be0bd28a79c3eb4ea7f0569a9059cbb000000000000000000000000", cpop.PermissionTargetAndSelector},
+		{permissionTarget, [4]byte{}, "0x550d3de95be0bd28a79c3eb4ea7f056900000000000000000000000000000000", cpop.PermissionTargetOnly},
+		{common.Address{}, transferSelector, "0x00000000000000000000000000000000a9059cbb000000000000000000000000", cpop.PermissionSelectorOnly},
+		{common.Address{}, [4]byte{}, "0x0000000000000000000000000000000000000000000000000000000000000000", cpop.PermissionAllowAll},
+	} {
+		p := cpop.NewSessionPermission(c.target, c.selector)
+		if p.String() != c.want || p.Kind() != c.kind {
+			t.Errorf("NewSessionPermission(%s, %x) = %s %s, want %s %s", c.target.Hex(), c.selector, p, p.Kind(), c.want, c.kind)
+		}
+		raw := hexutil.MustDecode(c.want)
+		if h := p.TargetHash(); string(h[:]) != string(raw[:16]) {
+			t.Errorf("%s target hash %x", p, h)
+		}
+		if s := p.Selector(); s != c.selector {
+			t.Errorf("%s selector %x", p, s)
+		}
+	}
+	if p, err := cpop.NewSessionPermissionForMethod(permissionTarget, "transfer(address,uint256)"); err != nil || p.Selector() != transferSelector {
+		t.Errorf("NewSessionPermissionForMethod = %s, %v", p, err)
+	}
+	// Flags alone restrict nothing.
+	var flags cpop.SessionPermission
+	flags[31] = 1
+	if f := flags.Flags(); f[11] != 1 || flags.Kind() != cpop.PermissionAllowAll || !flags.Allows(common.Address{1}, [4]byte{1}) {
+		t.Errorf("flags only permission %s: kind %s", flags, flags.Kind())
+	}
+}
+
+// TestSessionPermissionContract adds session keys with each kind of
+// permission through SessionKeyManager and checks Allows against
+// AAccount.canSessionKeyExecute.
+func TestSessionPermissionContract(t *testing.T) {
+	f := newSessionFixture(t)
+	var flags cpop.SessionPermission
+	flags[20], flags[31] = 0xff, 0x01
+	permissions := []cpop.SessionPermission{
+		cpop.NewSessionPermission(permissionTarget, transferSelector),
+		cpop.NewSessionPermission(permissionTarget, [4]byte{}),
+		cpop.NewSessionPermission(common.Address{}, transferSelector),
+		{},
+		flags,
+	}
+	withFlags := cpop.NewSessionPermission(permissionTarget, transferSelector)
+	copy(withFlags[20:], flags[20:])
+	permissions = append(permissions, withFlags)
+
+	targets := []common.Address{permissionTarget, f.account, {}}
+	selectors := [][4]byte{transferSelector, {0x09, 0x5e, 0xa7, 0xb3}, {}}
+	for _, p := range permissions {
+		key, session := f.addSessionKey(t, p)
+		for _, target := range targets {
+			for _, selector := range selectors {
+				name := fmt.Sprintf("%s on %s %x", p, target.Hex(), selector)
+				want, err := f.aa.CanSessionKeyExecute(nil, crypto.PubkeyToAddress(key.PublicKey), target, selector)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := p.Allows(target, selector); got != want {
+					t.Errorf("%s: Allows = %v, contract %v", name, got, want)
+				}
+				// The key was added in the latest block.
+				if got := session.CanExecute(target, selector, session.ValidAfter); got != want {
+					t.Errorf("%s: CanExecute = %v, contract %v", name, got, want)
+				}
+			}
+		}
+	}
+}
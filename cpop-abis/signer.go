@@ -0,0 +1,260 @@
+package cpop
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignerRole identifies the AAccount._validateSignature path a signature takes.
+type SignerRole int
+
+const (
+	// RoleOwner signs as the account owner.
+	RoleOwner SignerRole = iota
+	// RoleMasterSigner signs as the account's master signer.
+	RoleMasterSigner
+	// RoleSessionKey signs with a session key registered on the account.
+	RoleSessionKey
+)
+
+func (r SignerRole) String() string {
+	switch r {
+	case RoleOwner:
+		return "owner"
+	case RoleMasterSigner:
+		return "master-signer"
+	case RoleSessionKey:
+		return "session-key"
+	default:
+		return fmt.Sprintf("SignerRole(%d)", int(r))
+	}
+}
+
+var (
+	// ErrSessionKeyInactive is returned when the session key is not registered or was revoked.
+	ErrSessionKeyInactive = errors.New("session key is not active")
+	// ErrSessionKeyNotYetValid is returned before the session key's validAfter.
+	ErrSessionKeyNotYetValid = errors.New("session key is not valid yet")
+	// ErrSessionKeyExpired is returned after the session key's validUntil.
+	ErrSessionKeyExpired = errors.New("session key has expired")
+	// ErrSessionPermissionDenied is returned when the call is outside the session key permissions.
+	ErrSessionPermissionDenied = errors.New("call not allowed by session key permissions")
+)
+
+// UserOpSigner produces AAccount signatures for user operations.
+type UserOpSigner interface {
+	// Role reports which validation path the signature takes.
+	Role() SignerRole
+	// Address is the address recovered from the signature.
+	Address() common.Address
+	// SignUserOp signs userOpHash and stores the signature in op.
+	SignUserOp(op *PackedUserOperation, userOpHash common.Hash) error
+}
+
+// SignUserOpHash signs the eth-signed-message digest of userOpHash, which is
+// what AAccount recovers, and returns the 65 byte signature with v in {27, 28}.
+func SignUserOpHash(key *ecdsa.PrivateKey, userOpHash common.Hash) ([]byte, error) {
+	sig, err := crypto.Sign(accounts.TextHash(userOpHash.Bytes()), key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// RecoverUserOpSigner returns the address that produced sig over userOpHash.
+func RecoverUserOpSigner(userOpHash common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+	normalized := make([]byte, len(sig))
+	copy(normalized, sig)
+	if normalized[crypto.RecoveryIDOffset] >= 27 {
+		normalized[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(accounts.TextHash(userOpHash.Bytes()), normalized)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// keySigner is the ECDSA signer shared by the owner and master implementations.
+type keySigner struct {
+	key  *ecdsa.PrivateKey
+	role SignerRole
+}
+
+func (s *keySigner) Role() SignerRole { return s.role }
+
+func (s *keySigner) Address() common.Address { return crypto.PubkeyToAddress(s.key.PublicKey) }
+
+func (s *keySigner) SignUserOp(op *PackedUserOperation, userOpHash common.Hash) error {
+	sig, err := SignUserOpHash(s.key, userOpHash)
+	if err != nil {
+		return err
+	}
+	op.Signature = sig
+	return nil
+}
+
+// NewOwnerSigner returns a signer for the account owner key.
+func NewOwnerSigner(key *ecdsa.PrivateKey) UserOpSigner {
+	return &keySigner{key: key, role: RoleOwner}
+}
+
+// NewMasterSigner returns a signer for the account master signer key.
+func NewMasterSigner(key *ecdsa.PrivateKey) UserOpSigner {
+	return &keySigner{key: key, role: RoleMasterSigner}
+}
+
+// SessionKeySigner signs with a session key after checking the key's validity
+// window and permissions the same way AAccount does, so that operations the
+// account would reject are never signed.
+type SessionKeySigner struct {
+	key     *ecdsa.PrivateKey
+	session SessionKey
+
+	// Now returns the timestamp the validity window is checked against. It
+	// defaults to wall-clock time; set it to follow a block or test clock.
+	Now func() uint64
+}
+
+// NewSessionKeySigner returns a signer for key registered on the account with session.
+func NewSessionKeySigner(key *ecdsa.PrivateKey, session SessionKey) *SessionKeySigner {
+	return &SessionKeySigner{
+		key:     key,
+		session: session,
+		Now:     func() uint64 { return uint64(time.Now().Unix()) },
+	}
+}
+
+// LoadSessionKeySigner reads the session key data of key from the AAccount at account.
+func LoadSessionKeySigner(opts *bind.CallOpts, backend bind.ContractCaller, account common.Address, key *ecdsa.PrivateKey) (*SessionKeySigner, error) {
+	caller, err := NewAAccountCaller(account, backend)
+	if err != nil {
+		return nil, err
+	}
+	data, err := caller.SessionKeys(opts, crypto.PubkeyToAddress(key.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("session key data: %w", err)
+	}
+	return NewSessionKeySigner(key, NewSessionKey(data.ValidAfter, data.ValidUntil, data.Permissions, data.IsActive)), nil
+}
+
+func (s *SessionKeySigner) Role() SignerRole { return RoleSessionKey }
+
+func (s *SessionKeySigner) Address() common.Address { return crypto.PubkeyToAddress(s.key.PublicKey) }
+
+// Session returns the session key data the signer checks against.
+func (s *SessionKeySigner) Session() SessionKey { return s.session }
+
+// Check reports why AAccount would reject op signed by this session key, or
+// nil if it would accept it.
+func (s *SessionKeySigner) Check(op *PackedUserOperation) error {
+	now := s.Now()
+	switch {
+	case !s.session.IsActive:
+		return ErrSessionKeyInactive
+	case now < s.session.ValidAfter:
+		return fmt.Errorf("%w: valid after %d, now %d", ErrSessionKeyNotYetValid, s.session.ValidAfter, now)
+	case now > s.session.ValidUntil:
+		return fmt.Errorf("%w: valid until %d, now %d", ErrSessionKeyExpired, s.session.ValidUntil, now)
+	}
+	if !sessionPermissionAllowsOp(s.session.Permissions, op) {
+		return ErrSessionPermissionDenied
+	}
+	return nil
+}
+
+// SignUserOp checks op with Check and signs it.
+func (s *SessionKeySigner) SignUserOp(op *PackedUserOperation, userOpHash common.Hash) error {
+	if err := s.Check(op); err != nil {
+		return err
+	}
+	sig, err := SignUserOpHash(s.key, userOpHash)
+	if err != nil {
+		return err
+	}
+	op.Signature = sig
+	return nil
+}
+
+// sessionPermissionAllowsOp mirrors AAccount._validateSessionKeyPermission.
+func sessionPermissionAllowsOp(permissions SessionPermission, op *PackedUserOperation) bool {
+	data := op.CallData
+	if len(data) < 4 {
+		return false
+	}
+	parsed, err := AAccountMetaData.GetAbi()
+	if err != nil {
+		return false
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	switch {
+	case string(selector[:]) == string(parsed.Methods["execute"].ID):
+		if len(data) < 68 {
+			return false
+		}
+		target := common.BytesToAddress(data[16:36])
+		// AAccount reads the selector from callData[68:72], which is the head of
+		// the bytes offset word rather than the inner call, so for ABI encoded
+		// calldata it is zero and only target or allow-all permissions pass.
+		var inner [4]byte
+		if len(data) >= 72 {
+			copy(inner[:], data[68:72])
+		}
+		return permissions.Allows(target, inner)
+	case string(selector[:]) == string(parsed.Methods["executeBatch"].ID):
+		// AAccount only accepts batches from unrestricted session keys.
+		return permissions == SessionPermission{}
+	default:
+		return permissions.Allows(op.Sender, selector)
+	}
+}
+
+// ClassifySignature reports which AAccount._validateSignature path sig over
+// userOpHash takes on the account at account, checking the owner, the master
+// signer and the registered session keys in the contract's order. It does not
+// check the session key validity window or permissions.
+func ClassifySignature(opts *bind.CallOpts, backend bind.ContractCaller, account common.Address, userOpHash common.Hash, sig []byte) (SignerRole, common.Address, error) {
+	recovered, err := RecoverUserOpSigner(userOpHash, sig)
+	if err != nil {
+		return 0, common.Address{}, err
+	}
+	caller, err := NewAAccountCaller(account, backend)
+	if err != nil {
+		return 0, recovered, err
+	}
+	owner, err := caller.GetOwner(opts)
+	if err != nil {
+		return 0, recovered, fmt.Errorf("account owner: %w", err)
+	}
+	if recovered == owner {
+		return RoleOwner, recovered, nil
+	}
+	master, err := caller.GetMasterSigner(opts)
+	if err != nil {
+		return 0, recovered, fmt.Errorf("account master signer: %w", err)
+	}
+	if master != (common.Address{}) && recovered == master {
+		return RoleMasterSigner, recovered, nil
+	}
+	data, err := caller.SessionKeys(opts, recovered)
+	if err != nil {
+		return 0, recovered, fmt.Errorf("session key data: %w", err)
+	}
+	if !data.IsActive {
+		return 0, recovered, fmt.Errorf("%w: %s is not the owner, master signer or a session key", ErrSessionKeyInactive, recovered.Hex())
+	}
+	return RoleSessionKey, recovered, nil
+}
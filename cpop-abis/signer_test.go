@@ -0,0 +1,117 @@
+package cpop_test
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+)
+
+// TestSessionKeySignerContract checks SessionKeySigner.Check against
+// AAccount.validateUserOp, called as the EntryPoint, for call data that puts
+// different bytes at callData[68:72], where the account reads the selector
+// of an execute call.
+func TestSessionKeySignerContract(t *testing.T) {
+	f := newSessionFixture(t)
+	accountABI, err := cpop.AAccountMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	transfer := append(transferSelector[:], make([]byte, 64)...)
+	revoke, err := accountABI.Pack("revokeSessionKey", other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mustEncode := func(data []byte, err error) []byte {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	sigFailedMask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+	execute := accountABI.Methods["execute"].ID
+	// packed is execute(target, 0) followed by the inner selector alone,
+	// the only layout that puts a selector at callData[68:72].
+	packed := func(target common.Address, selector []byte) []byte {
+		data := append([]byte{}, execute...)
+		data = append(data, common.LeftPadBytes(target.Bytes(), 32)...)
+		data = append(data, make([]byte, 32)...)
+		return append(data, selector...)
+	}
+	callData := []struct {
+		name  string
+		data  []byte
+		slot  [4]byte // callData[68:72] as AAccount reads it
+		short bool    // too short to have the slot
+	}{
+		// ABI encoding puts the zero high bytes of the data offset word there.
+		{name: "execute", data: mustEncode(cpop.EncodeExecute(permissionTarget, nil, transfer))},
+		{name: "execute other", data: mustEncode(cpop.EncodeExecute(other, nil, transfer))},
+		{name: "packed", data: packed(permissionTarget, transferSelector[:]), slot: transferSelector},
+		{name: "packed without selector", data: packed(permissionTarget, nil), short: true},
+		{name: "truncated", data: packed(permissionTarget, nil)[:67], short: true},
+		{name: "batch", data: mustEncode(cpop.EncodeExecuteBatch([]cpop.BaseAccountCall{{Target: permissionTarget, Data: transfer}}))},
+		{name: "account call", data: revoke, short: true},
+		{name: "no selector", data: execute[:3], short: true},
+	}
+	for _, c := range callData {
+		if len(c.data) >= 72 && [4]byte(c.data[68:72]) != c.slot {
+			t.Fatalf("%s: callData[68:72] = %x, want %x", c.name, c.data[68:72], c.slot)
+		}
+		if (len(c.data) < 72) != c.short {
+			t.Fatalf("%s: %d bytes of call data", c.name, len(c.data))
+		}
+	}
+
+	for _, c := range []struct {
+		permission cpop.SessionPermission
+		allowed    []string
+	}{
+		{cpop.NewSessionPermission(permissionTarget, transferSelector), []string{"packed"}},
+		{cpop.NewSessionPermission(permissionTarget, [4]byte{}), []string{"execute", "packed", "packed without selector"}},
+		{cpop.NewSessionPermission(common.Address{}, transferSelector), []string{"packed"}},
+		{cpop.NewSessionPermission(f.account, [4]byte(revoke)), []string{"account call"}},
+		{cpop.SessionPermission{}, []string{"execute", "execute other", "packed", "packed without selector", "batch", "account call"}},
+	} {
+		key, session := f.addSessionKey(t, c.permission)
+		signer := cpop.NewSessionKeySigner(key, session)
+		signer.Now = func() uint64 { return session.ValidAfter }
+		raw := &cpop.AAccountRaw{Contract: f.aa}
+		for _, d := range callData {
+			op := cpop.PackedUserOperation{
+				Sender:             f.account,
+				Nonce:              big.NewInt(0),
+				InitCode:           []byte{},
+				CallData:           d.data,
+				PreVerificationGas: big.NewInt(0),
+				PaymasterAndData:   []byte{},
+			}
+			hash := crypto.Keccak256Hash(d.data)
+			if op.Signature, err = cpop.SignUserOpHash(key, hash); err != nil {
+				t.Fatal(err)
+			}
+			var out []interface{}
+			if err := raw.Call(&bind.CallOpts{From: f.core.Addresses.EntryPoint}, &out, "validateUserOp", op, hash, big.NewInt(0)); err != nil {
+				t.Fatal(cpop.AsRevertError(err))
+			}
+			// The low 160 bits are zero for a valid signature.
+			accepted := new(big.Int).And(out[0].(*big.Int), sigFailedMask).Sign() == 0
+			want := slices.Contains(c.allowed, d.name)
+			checked := signer.Check(&op)
+			if accepted != want || (checked == nil) != want {
+				t.Errorf("%s with %s: contract accepted %v, Check %v, want allowed %v", c.permission.Kind(), d.name, accepted, checked, want)
+			}
+			if !want && !errors.Is(checked, cpop.ErrSessionPermissionDenied) {
+				t.Errorf("%s with %s: Check = %v, want ErrSessionPermissionDenied", c.permission.Kind(), d.name, checked)
+			}
+		}
+	}
+}
@@ -0,0 +1,455 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// DefaultStakingBatchSize is the largest number of tokens StakingBatcher
+	// puts into one transaction when MaxBatch is zero.
+	DefaultStakingBatchSize = 50
+	// DefaultStakingBatchGas is the gas budget of one batch transaction when
+	// BatchGas is zero. Batches estimated above it are split.
+	DefaultStakingBatchGas = 8_000_000
+)
+
+// ErrBatchEventMissing is returned when a mined batch transaction lacks the
+// Staking batch event it should emit.
+var ErrBatchEventMissing = errors.New("batch event missing from receipt")
+
+// StakingBatchOp is a Staking batch function.
+type StakingBatchOp int
+
+const (
+	// StakingOpStake is Staking.batchStake.
+	StakingOpStake StakingBatchOp = iota
+	// StakingOpUnstake is Staking.batchUnstake.
+	StakingOpUnstake
+	// StakingOpClaim is Staking.batchClaimRewards.
+	StakingOpClaim
+)
+
+func (op StakingBatchOp) String() string {
+	switch op {
+	case StakingOpStake:
+		return "stake"
+	case StakingOpUnstake:
+		return "unstake"
+	case StakingOpClaim:
+		return "claim"
+	default:
+		return fmt.Sprintf("StakingBatchOp(%d)", int(op))
+	}
+}
+
+// method returns the Staking method name of op.
+func (op StakingBatchOp) method() string {
+	switch op {
+	case StakingOpStake:
+		return "batchStake"
+	case StakingOpUnstake:
+		return "batchUnstake"
+	default:
+		return "batchClaimRewards"
+	}
+}
+
+// StakingTokenStatus is the outcome of one token of a batch operation.
+type StakingTokenStatus int
+
+const (
+	// StakingTokenPending means the token is valid but was not sent because
+	// the run stopped early.
+	StakingTokenPending StakingTokenStatus = iota
+	// StakingTokenDone means the token was included in a mined batch.
+	StakingTokenDone
+	// StakingTokenNotOwner means the user does not own the token or its stake.
+	StakingTokenNotOwner
+	// StakingTokenAlreadyStaked means a token to stake is already staked.
+	StakingTokenAlreadyStaked
+	// StakingTokenNotStaked means a token to unstake or claim for is not staked.
+	StakingTokenNotStaked
+	// StakingTokenNormalLevel means a token to stake has the NORMAL level.
+	StakingTokenNormalLevel
+	// StakingTokenNoRewards means a token to claim for has no pending rewards.
+	// Staking only requires the rewards of a whole batch to be non-zero; such
+	// tokens are left out anyway as they would claim nothing.
+	StakingTokenNoRewards
+	// StakingTokenDuplicate means the token appeared earlier in the request.
+	StakingTokenDuplicate
+	// StakingTokenReverted means the token still reverted when sent alone.
+	StakingTokenReverted
+)
+
+func (s StakingTokenStatus) String() string {
+	switch s {
+	case StakingTokenPending:
+		return "pending"
+	case StakingTokenDone:
+		return "done"
+	case StakingTokenNotOwner:
+		return "not-owner"
+	case StakingTokenAlreadyStaked:
+		return "already-staked"
+	case StakingTokenNotStaked:
+		return "not-staked"
+	case StakingTokenNormalLevel:
+		return "normal-level"
+	case StakingTokenNoRewards:
+		return "no-rewards"
+	case StakingTokenDuplicate:
+		return "duplicate"
+	case StakingTokenReverted:
+		return "reverted"
+	default:
+		return fmt.Sprintf("StakingTokenStatus(%d)", int(s))
+	}
+}
+
+// StakingTokenOutcome is the result of one requested token.
+type StakingTokenOutcome struct {
+	TokenID *big.Int
+	Status  StakingTokenStatus
+	Reason  string      // revert reason or validation detail
+	Tx      common.Hash // batch transaction, zero unless done
+	Rewards *big.Int    // from NFTUnstaked or RewardsClaimed, nil for stakes
+}
+
+// StakingBatchReport is the result of a batch operation. Its outcomes follow
+// the order of the requested token IDs.
+type StakingBatchReport struct {
+	Op       StakingBatchOp
+	User     common.Address
+	Outcomes []StakingTokenOutcome
+	Txs      []common.Hash // mined batch transactions in order
+}
+
+// Done returns the IDs of the tokens included in a mined batch.
+func (r *StakingBatchReport) Done() []*big.Int {
+	var ids []*big.Int
+	for _, o := range r.Outcomes {
+		if o.Status == StakingTokenDone {
+			ids = append(ids, o.TokenID)
+		}
+	}
+	return ids
+}
+
+// Failed returns the outcomes of the tokens that were not processed.
+func (r *StakingBatchReport) Failed() []StakingTokenOutcome {
+	var failed []StakingTokenOutcome
+	for _, o := range r.Outcomes {
+		if o.Status != StakingTokenDone {
+			failed = append(failed, o)
+		}
+	}
+	return failed
+}
+
+// TotalRewards returns the rewards minted for the done tokens.
+func (r *StakingBatchReport) TotalRewards() *big.Int {
+	total := new(big.Int)
+	for _, o := range r.Outcomes {
+		if o.Rewards != nil {
+			total.Add(total, o.Rewards)
+		}
+	}
+	return total
+}
+
+// StakingBatchBackend is the node access needed by StakingBatcher.
+type StakingBatchBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// StakingBatcher runs Staking.batchStake, batchUnstake and batchClaimRewards
+// on behalf of users without letting one invalid token revert the whole
+// request. Tokens are checked against CPNFT.ownerOf, isStaked and
+// Staking.stakes first, the rest is split into batches within MaxBatch and
+// BatchGas, and batches that still revert are bisected until the failing
+// tokens are isolated.
+type StakingBatcher struct {
+	MaxBatch int    // tokens per transaction, DefaultStakingBatchSize if zero
+	BatchGas uint64 // gas per transaction, DefaultStakingBatchGas if zero
+
+	staking *Staking
+	nft     *CPNFT
+	address common.Address
+	abi     *abi.ABI
+	backend StakingBatchBackend
+}
+
+// NewStakingBatcher binds the Staking contract and its CPNFT.
+func NewStakingBatcher(staking, nft common.Address, backend StakingBatchBackend) (*StakingBatcher, error) {
+	parsed, err := StakingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewStaking(staking, backend)
+	if err != nil {
+		return nil, err
+	}
+	n, err := NewCPNFT(nft, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingBatcher{staking: s, nft: n, address: staking, abi: parsed, backend: backend}, nil
+}
+
+// Stake stakes the tokens of user. auth must be the Staking owner.
+func (b *StakingBatcher) Stake(ctx context.Context, auth *bind.TransactOpts, user common.Address, tokenIDs []*big.Int) (*StakingBatchReport, error) {
+	return b.Run(ctx, auth, StakingOpStake, user, tokenIDs)
+}
+
+// Unstake unstakes the tokens of user. auth must be the Staking owner.
+func (b *StakingBatcher) Unstake(ctx context.Context, auth *bind.TransactOpts, user common.Address, tokenIDs []*big.Int) (*StakingBatchReport, error) {
+	return b.Run(ctx, auth, StakingOpUnstake, user, tokenIDs)
+}
+
+// Claim claims the rewards of the tokens of user. auth must be the Staking owner.
+func (b *StakingBatcher) Claim(ctx context.Context, auth *bind.TransactOpts, user common.Address, tokenIDs []*big.Int) (*StakingBatchReport, error) {
+	return b.Run(ctx, auth, StakingOpClaim, user, tokenIDs)
+}
+
+// Run validates tokenIDs, sends op in batches and waits for each to be
+// mined. The report is returned together with any error that stopped the run
+// early, in which case the tokens not sent yet are StakingTokenPending.
+func (b *StakingBatcher) Run(ctx context.Context, auth *bind.TransactOpts, op StakingBatchOp, user common.Address, tokenIDs []*big.Int) (*StakingBatchReport, error) {
+	report := &StakingBatchReport{Op: op, User: user, Outcomes: make([]StakingTokenOutcome, len(tokenIDs))}
+	opts := &bind.CallOpts{Context: ctx}
+	seen := make(map[string]bool)
+	var valid []int
+	for i, id := range tokenIDs {
+		o := &report.Outcomes[i]
+		o.TokenID = id
+		if seen[id.String()] {
+			o.Status = StakingTokenDuplicate
+			continue
+		}
+		seen[id.String()] = true
+		status, reason, err := b.validate(opts, op, user, id)
+		if err != nil {
+			return report, fmt.Errorf("token %s: %w", id, err)
+		}
+		if status != StakingTokenPending {
+			o.Status, o.Reason = status, reason
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	maxBatch := b.MaxBatch
+	if maxBatch <= 0 {
+		maxBatch = DefaultStakingBatchSize
+	}
+	var queue [][]int
+	for start := 0; start < len(valid); start += maxBatch {
+		queue = append(queue, valid[start:min(start+maxBatch, len(valid))])
+	}
+	for len(queue) > 0 {
+		batch := queue[0]
+		queue = queue[1:]
+		split, err := b.send(ctx, auth, report, batch)
+		if err != nil {
+			return report, err
+		}
+		if split {
+			half := len(batch) / 2
+			queue = append([][]int{batch[:half], batch[half:]}, queue...)
+		}
+	}
+	return report, nil
+}
+
+// validate mirrors the per-token requires of op, returning
+// StakingTokenPending for tokens that can be sent. Claims are stricter than
+// Staking.batchClaimRewards, which only requires a non-zero total: tokens
+// without pending rewards are turned down, so that no batch is made of them
+// alone.
+func (b *StakingBatcher) validate(opts *bind.CallOpts, op StakingBatchOp, user common.Address, id *big.Int) (StakingTokenStatus, string, error) {
+	if op == StakingOpStake {
+		owner, err := b.nft.OwnerOf(opts, id)
+		if err != nil {
+			if revert, ok := DecodeRevert(err); ok {
+				return StakingTokenNotOwner, revert.Error(), nil
+			}
+			return 0, "", fmt.Errorf("owner: %w", err)
+		}
+		if owner != user {
+			return StakingTokenNotOwner, "Not the owner of this NFT", nil
+		}
+		staked, err := b.nft.IsStaked(opts, id)
+		if err != nil {
+			return 0, "", fmt.Errorf("stake status: %w", err)
+		}
+		stake, err := b.staking.Stakes(opts, id)
+		if err != nil {
+			return 0, "", fmt.Errorf("stake: %w", err)
+		}
+		if staked || stake.IsActive {
+			return StakingTokenAlreadyStaked, "NFT is already staked", nil
+		}
+		level, err := b.nft.GetTokenLevel(opts, id)
+		if err != nil {
+			return 0, "", fmt.Errorf("level: %w", err)
+		}
+		if level == 0 {
+			return StakingTokenNormalLevel, "NORMAL level NFTs cannot be staked", nil
+		}
+		return StakingTokenPending, "", nil
+	}
+
+	stake, err := b.staking.Stakes(opts, id)
+	if err != nil {
+		return 0, "", fmt.Errorf("stake: %w", err)
+	}
+	if stake.Owner != user {
+		return StakingTokenNotOwner, "Not the owner of this stake", nil
+	}
+	if !stake.IsActive {
+		return StakingTokenNotStaked, "NFT is not staked", nil
+	}
+	if op == StakingOpClaim {
+		pending, err := b.staking.CalculatePendingRewards(opts, id)
+		if err != nil {
+			return 0, "", fmt.Errorf("pending rewards: %w", err)
+		}
+		if pending.Sign() == 0 {
+			return StakingTokenNoRewards, "No pending rewards", nil
+		}
+	}
+	return StakingTokenPending, "", nil
+}
+
+// send estimates and sends one batch. It reports whether the batch must be
+// split: when it is over the gas budget or reverts with more than one token.
+// A single reverting token is recorded as StakingTokenReverted.
+func (b *StakingBatcher) send(ctx context.Context, auth *bind.TransactOpts, report *StakingBatchReport, batch []int) (bool, error) {
+	ids := make([]*big.Int, len(batch))
+	for i, idx := range batch {
+		ids[i] = report.Outcomes[idx].TokenID
+	}
+	data, err := b.abi.Pack(report.Op.method(), report.User, ids)
+	if err != nil {
+		return false, err
+	}
+	gas, err := b.backend.EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &b.address, Data: data})
+	if err != nil {
+		revert, ok := DecodeRevert(err)
+		if !ok {
+			return false, fmt.Errorf("estimate %s of %d tokens: %w", report.Op, len(ids), err)
+		}
+		return b.reverted(report, batch, revert.Error()), nil
+	}
+	budget := b.BatchGas
+	if budget == 0 {
+		budget = DefaultStakingBatchGas
+	}
+	if gas > budget && len(batch) > 1 {
+		return true, nil
+	}
+
+	opts := *auth
+	opts.Context = ctx
+	opts.GasLimit = gas + gas/5
+	var tx *types.Transaction
+	switch report.Op {
+	case StakingOpStake:
+		tx, err = b.staking.BatchStake(&opts, report.User, ids)
+	case StakingOpUnstake:
+		tx, err = b.staking.BatchUnstake(&opts, report.User, ids)
+	default:
+		tx, err = b.staking.BatchClaimRewards(&opts, report.User, ids)
+	}
+	if err != nil {
+		return false, fmt.Errorf("send %s of %d tokens: %w", report.Op, len(ids), AsRevertError(err))
+	}
+	receipt, err := bind.WaitMined(ctx, b.backend, tx)
+	if err != nil {
+		return false, fmt.Errorf("wait for %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return b.reverted(report, batch, "transaction "+tx.Hash().Hex()+" reverted"), nil
+	}
+	if err := b.record(report, batch, receipt); err != nil {
+		return false, err
+	}
+	report.Txs = append(report.Txs, tx.Hash())
+	return false, nil
+}
+
+// reverted handles a reverting batch, returning whether it should be split.
+func (b *StakingBatcher) reverted(report *StakingBatchReport, batch []int, reason string) bool {
+	if len(batch) > 1 {
+		return true
+	}
+	o := &report.Outcomes[batch[0]]
+	o.Status, o.Reason = StakingTokenReverted, reason
+	return false
+}
+
+// record marks the tokens of a mined batch done, checking them against its
+// BatchStaked, BatchUnstaked or BatchRewardsClaimed event and taking the
+// rewards from the per-token NFTUnstaked and RewardsClaimed events.
+func (b *StakingBatcher) record(report *StakingBatchReport, batch []int, receipt *types.Receipt) error {
+	var (
+		found   bool
+		rewards = make(map[string]*big.Int)
+	)
+	for _, log := range receipt.Logs {
+		if log.Address != b.address || len(log.Topics) == 0 {
+			continue
+		}
+		switch report.Op {
+		case StakingOpStake:
+			if ev, err := b.staking.ParseBatchStaked(*log); err == nil && ev.User == report.User {
+				if len(ev.TokenIds) != len(batch) {
+					return fmt.Errorf("BatchStaked of %s lists %d tokens, sent %d", receipt.TxHash.Hex(), len(ev.TokenIds), len(batch))
+				}
+				found = true
+			}
+		case StakingOpUnstake:
+			if ev, err := b.staking.ParseNFTUnstaked(*log); err == nil {
+				rewards[ev.TokenId.String()] = ev.Rewards
+			} else if ev, err := b.staking.ParseBatchUnstaked(*log); err == nil && ev.User == report.User {
+				if len(ev.TokenIds) != len(batch) {
+					return fmt.Errorf("BatchUnstaked of %s lists %d tokens, sent %d", receipt.TxHash.Hex(), len(ev.TokenIds), len(batch))
+				}
+				found = true
+			}
+		case StakingOpClaim:
+			if ev, err := b.staking.ParseRewardsClaimed(*log); err == nil {
+				rewards[ev.TokenId.String()] = ev.Amount
+			} else if ev, err := b.staking.ParseBatchRewardsClaimed(*log); err == nil && ev.User == report.User {
+				if ev.TokenCount.Cmp(big.NewInt(int64(len(batch)))) != 0 {
+					return fmt.Errorf("BatchRewardsClaimed of %s counts %s tokens, sent %d", receipt.TxHash.Hex(), ev.TokenCount, len(batch))
+				}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrBatchEventMissing, receipt.TxHash.Hex())
+	}
+	for _, idx := range batch {
+		o := &report.Outcomes[idx]
+		o.Status, o.Tx = StakingTokenDone, receipt.TxHash
+		if report.Op != StakingOpStake {
+			o.Rewards = rewards[o.TokenID.String()]
+			if o.Rewards == nil {
+				o.Rewards = new(big.Int)
+			}
+		}
+	}
+	return nil
+}
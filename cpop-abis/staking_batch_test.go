@@ -0,0 +1,199 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// estimateHook runs before ahead of the first gas estimate, after the
+// tokens of a batch run were validated.
+type estimateHook struct {
+	autoMiner
+	before func()
+}
+
+func (b *estimateHook) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if before := b.before; before != nil {
+		b.before = nil
+		before()
+	}
+	return b.autoMiner.EstimateGas(ctx, call)
+}
+
+func TestStakingBatcher(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	userKey, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key, userKey)
+	defer sim.Close()
+	auth, userAuth := cpoptest.NewTransactor(key), cpoptest.NewTransactor(userKey)
+	user, other := userAuth.From, common.HexToAddress("0x00000000000000000000000000000000000000dd")
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := cpoptest.DeployStaking(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine := func(tx interface{ Hash() common.Hash }, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		sim.Commit()
+	}
+	// Tokens 1 to 6 are C, 7 is NORMAL, 8 belongs to someone else.
+	for _, level := range []uint8{levelC, levelC, levelC, levelC, levelC, levelC, 0} {
+		mine(s.CPNFT.Mint(auth, user, level))
+	}
+	mine(s.CPNFT.Mint(auth, other, levelC))
+	clock, err := cpoptest.NewTestClock(ctx, sim.Client(), auth, &cpoptest.TestClockOptions{Simulated: sim}, s.Staking)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := &estimateHook{autoMiner: autoMiner{sim.Client(), sim}}
+	batcher, err := cpop.NewStakingBatcher(s.Addresses.Staking, s.Addresses.CPNFT, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := &cpop.StakingRaw{Contract: s.Staking}
+	account, err := core.AccountManager.GetAccountAddress(nil, user, common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := func(ns ...int64) []*big.Int {
+		out := make([]*big.Int, len(ns))
+		for i, n := range ns {
+			out[i] = big.NewInt(n)
+		}
+		return out
+	}
+	// run runs op and checks the outcome statuses. Tokens turned down by
+	// the validation must revert on their own with the same reason.
+	run := func(op cpop.StakingBatchOp, tokens []*big.Int, want ...cpop.StakingTokenStatus) *cpop.StakingBatchReport {
+		t.Helper()
+		report, err := batcher.Run(ctx, auth, op, user, tokens)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []cpop.StakingTokenStatus
+		for _, o := range report.Outcomes {
+			got = append(got, o.Status)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s statuses %v, want %v", op, got, want)
+		}
+		method := map[cpop.StakingBatchOp]string{cpop.StakingOpStake: "batchStake", cpop.StakingOpUnstake: "batchUnstake", cpop.StakingOpClaim: "batchClaimRewards"}[op]
+		for _, o := range report.Outcomes {
+			switch o.Status {
+			case cpop.StakingTokenDone, cpop.StakingTokenDuplicate, cpop.StakingTokenReverted:
+				continue
+			}
+			var out []interface{}
+			err := raw.Call(&bind.CallOpts{From: auth.From}, &out, method, user, []*big.Int{o.TokenID})
+			if revert, ok := cpop.DecodeRevert(err); !ok || revert.Error() != o.Reason {
+				t.Errorf("%s of token %s: %s rejected with %q, contract %v", op, o.TokenID, o.Status, o.Reason, err)
+			}
+		}
+		return report
+	}
+	balance := func() *big.Int {
+		t.Helper()
+		b, err := core.CPOPToken.BalanceOf(nil, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	// Token 3 changes hands after validation, so the first batch reverts
+	// and is bisected down to it.
+	backend.before = func() { mine(s.CPNFT.TransferFrom(userAuth, user, other, big.NewInt(3))) }
+	report := run(cpop.StakingOpStake, ids(1, 2, 3, 4, 5, 7, 8, 99, 2),
+		cpop.StakingTokenDone, cpop.StakingTokenDone, cpop.StakingTokenReverted, cpop.StakingTokenDone, cpop.StakingTokenDone,
+		cpop.StakingTokenNormalLevel, cpop.StakingTokenNotOwner, cpop.StakingTokenNotOwner, cpop.StakingTokenDuplicate)
+	if len(report.Txs) != 2 {
+		t.Fatalf("%d stake transactions, want 2", len(report.Txs))
+	}
+	for i, tx := range []common.Hash{report.Txs[0], report.Txs[0], {}, report.Txs[1], report.Txs[1]} {
+		if o := report.Outcomes[i]; o.Tx != tx {
+			t.Errorf("token %s in %s, want %s", o.TokenID, o.Tx.Hex(), tx.Hex())
+		}
+	}
+	if o := report.Outcomes[2]; o.Reason != "Not the owner of this NFT" {
+		t.Errorf("token 3 reverted with %q", o.Reason)
+	}
+	if done := report.Done(); len(done) != 4 || len(report.Failed()) != 5 {
+		t.Errorf("done %v, %d failed", done, len(report.Failed()))
+	}
+	for _, id := range report.Done() {
+		if stake, err := s.Staking.Stakes(nil, id); err != nil || !stake.IsActive || stake.Owner != user {
+			t.Errorf("token %s stake %+v, %v", id, stake, err)
+		}
+	}
+	run(cpop.StakingOpStake, ids(1), cpop.StakingTokenAlreadyStaked)
+
+	// A token staked just now has no rewards to claim yet. The rest are
+	// claimed in batches of three, with the amounts of RewardsClaimed.
+	if err := clock.AdvanceDays(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	run(cpop.StakingOpStake, ids(6), cpop.StakingTokenDone)
+	pending := make(map[int64]*big.Int)
+	for _, id := range ids(1, 2, 4, 5) {
+		if pending[id.Int64()], err = s.Staking.CalculatePendingRewards(nil, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before := balance()
+	batcher.MaxBatch = 3
+	report = run(cpop.StakingOpClaim, ids(1, 2, 4, 5, 6, 3),
+		cpop.StakingTokenDone, cpop.StakingTokenDone, cpop.StakingTokenDone, cpop.StakingTokenDone,
+		cpop.StakingTokenNoRewards, cpop.StakingTokenNotOwner)
+	if len(report.Txs) != 2 {
+		t.Fatalf("%d claim transactions, want 2", len(report.Txs))
+	}
+	for _, o := range report.Outcomes[:4] {
+		if want := pending[o.TokenID.Int64()]; want.Sign() == 0 || o.Rewards.Cmp(want) != 0 {
+			t.Errorf("token %s claimed %s, pending was %s", o.TokenID, o.Rewards, want)
+		}
+	}
+	if minted := new(big.Int).Sub(balance(), before); minted.Cmp(report.TotalRewards()) != 0 {
+		t.Errorf("claimed %s in total, account received %s", report.TotalRewards(), minted)
+	}
+
+	// Over the gas budget every batch is split down to single tokens, and
+	// NFTUnstaked carries the rewards of each.
+	if err := clock.AdvanceDays(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	before = balance()
+	batcher.MaxBatch, batcher.BatchGas = 0, 1
+	report = run(cpop.StakingOpUnstake, ids(1, 2, 6, 3),
+		cpop.StakingTokenDone, cpop.StakingTokenDone, cpop.StakingTokenDone, cpop.StakingTokenNotOwner)
+	if len(report.Txs) != 3 {
+		t.Fatalf("%d unstake transactions, want 3", len(report.Txs))
+	}
+	for _, o := range report.Outcomes[:3] {
+		if o.Rewards == nil || o.Rewards.Sign() == 0 {
+			t.Errorf("token %s unstaked with rewards %v", o.TokenID, o.Rewards)
+		}
+	}
+	if minted := new(big.Int).Sub(balance(), before); minted.Cmp(report.TotalRewards()) != 0 {
+		t.Errorf("unstaked with %s in total, account received %s", report.TotalRewards(), minted)
+	}
+	run(cpop.StakingOpUnstake, ids(1), cpop.StakingTokenNotStaked)
+	run(cpop.StakingOpClaim, ids(2), cpop.StakingTokenNotStaked)
+}
@@ -0,0 +1,133 @@
+package cpop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultStakingPageSize is the number of staked NFTs read per page when the
+// request gives no limit.
+const DefaultStakingPageSize = 20
+
+// StakingPageRequest selects the staking page of a user.
+type StakingPageRequest struct {
+	User   common.Address
+	Offset uint64 // first staked NFT of the page
+	Limit  uint64 // staked NFTs per page, DefaultStakingPageSize if zero
+	// TokenIDs are further tokens, e.g. the unstaked holdings of the user,
+	// whose CPNFT level and pending rewards are read with the page.
+	TokenIDs []*big.Int
+}
+
+// StakingPageToken is the level and pending rewards of a requested token.
+type StakingPageToken struct {
+	TokenID        *big.Int
+	Level          uint8
+	LevelErr       error // set when GetTokenLevel reverted, e.g. for a burned token
+	PendingRewards *big.Int
+	PendingErr     error // set when GetPendingRewards reverted, e.g. for an unstaked token
+}
+
+// StakingSnapshot is the staking page of a user read at a single block, so
+// that all its numbers agree with each other.
+type StakingSnapshot struct {
+	User        common.Address
+	BlockNumber uint64
+	Timestamp   uint64 // block time, not the Staking test clock
+
+	Summary      StakingReaderUserStakingSummary
+	Staked       []StakingReaderStakedNFTInfo // the requested page
+	TotalStaked  *big.Int                     // staked NFTs over all pages
+	Combo        StakingReaderUserComboSummary
+	RewardStats  StakingReaderUserRewardStats
+	DailyRewards StakingReaderUserDailyRewards
+	Tokens       []StakingPageToken // in the order of the request TokenIDs
+}
+
+// CallOpts returns call options pinned to the block of s, for further reads
+// consistent with it such as the next page.
+func (s *StakingSnapshot) CallOpts(ctx context.Context) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(s.BlockNumber)}
+}
+
+// StakingDashboard reads staking pages from StakingReader and CPNFT with one
+// Multicall3 eth_call per page.
+type StakingDashboard struct {
+	Reader    common.Address
+	NFT       common.Address
+	Multicall common.Address // Multicall3Address by default
+
+	backend bind.ContractCaller
+}
+
+// NewStakingDashboard reads through the StakingReader at reader and the CPNFT
+// at nft.
+func NewStakingDashboard(reader, nft common.Address, backend bind.ContractCaller) *StakingDashboard {
+	return &StakingDashboard{Reader: reader, NFT: nft, Multicall: Multicall3Address, backend: backend}
+}
+
+// Load reads the staking page of req.User in one eth_call. Without a block
+// number in opts the latest block is read and recorded in the snapshot; pass
+// s.CallOpts to read other pages at the same block.
+func (d *StakingDashboard) Load(opts *bind.CallOpts, req StakingPageRequest) (*StakingSnapshot, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultStakingPageSize
+	}
+	var (
+		s         = &StakingSnapshot{User: req.User, Tokens: make([]StakingPageToken, len(req.TokenIDs))}
+		timestamp *big.Int
+		staked    struct {
+			Nfts  []StakingReaderStakedNFTInfo
+			Total *big.Int
+		}
+		calls   []*MulticallCall
+		packErr error
+	)
+	add := func(meta *bind.MetaData, target common.Address, out interface{}, method string, args ...interface{}) *MulticallCall {
+		c, err := NewMulticallCall(meta, target, out, method, args...)
+		if err != nil {
+			packErr = errors.Join(packErr, err)
+			return new(MulticallCall)
+		}
+		calls = append(calls, c)
+		return c
+	}
+	add(multicall3MetaData, d.Multicall, &timestamp, "getCurrentBlockTimestamp")
+	add(StakingReaderMetaData, d.Reader, &s.Summary, "getUserStakingSummary", req.User)
+	add(StakingReaderMetaData, d.Reader, &staked, "getUserStakedNFTs", req.User, new(big.Int).SetUint64(req.Offset), new(big.Int).SetUint64(limit))
+	add(StakingReaderMetaData, d.Reader, &s.Combo, "getUserComboSummary", req.User)
+	add(StakingReaderMetaData, d.Reader, &s.RewardStats, "getUserRewardStats", req.User)
+	add(StakingReaderMetaData, d.Reader, &s.DailyRewards, "getUserDailyRewards", req.User)
+	levels := make([]*MulticallCall, len(req.TokenIDs))
+	pending := make([]*MulticallCall, len(req.TokenIDs))
+	for i, id := range req.TokenIDs {
+		t := &s.Tokens[i]
+		t.TokenID = id
+		levels[i] = add(CPNFTMetaData, d.NFT, &t.Level, "getTokenLevel", id)
+		levels[i].AllowFailure = true
+		pending[i] = add(StakingReaderMetaData, d.Reader, &t.PendingRewards, "getPendingRewards", id)
+		pending[i].AllowFailure = true
+	}
+	if packErr != nil {
+		return nil, packErr
+	}
+
+	// One more call reads the block number.
+	multicall := &Multicall{Address: d.Multicall, MaxBatch: len(calls) + 1, backend: d.backend}
+	block, err := multicall.Call(opts, calls...)
+	if err != nil {
+		return nil, fmt.Errorf("staking page of %s: %w", req.User.Hex(), err)
+	}
+	s.BlockNumber, s.Timestamp = block.Uint64(), timestamp.Uint64()
+	s.Staked, s.TotalStaked = staked.Nfts, staked.Total
+	for i := range s.Tokens {
+		s.Tokens[i].LevelErr, s.Tokens[i].PendingErr = levels[i].Err, pending[i].Err
+	}
+	return s, nil
+}
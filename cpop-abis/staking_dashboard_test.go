@@ -0,0 +1,179 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// TestStakingDashboardContract checks that a StakingDashboard snapshot
+// decodes every StakingReader struct as the StakingReader bindings do at the
+// same block, and that failing token reads land in LevelErr and PendingErr.
+func TestStakingDashboardContract(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	client := sim.Client()
+	auth := cpoptest.NewTransactor(key)
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := cpoptest.DeployStaking(core)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine := func(tx interface{ Hash() common.Hash }, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(cpop.AsRevertError(err))
+		}
+		sim.Commit()
+	}
+	ids := func(ns ...int64) []*big.Int {
+		out := make([]*big.Int, len(ns))
+		for i, n := range ns {
+			out[i] = big.NewInt(n)
+		}
+		return out
+	}
+
+	// Tokens 1 to 4 are staked, with a C combo; token 5 is held unstaked.
+	user := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	for _, level := range []uint8{levelC, levelC, levelC, levelB, levelC} {
+		mine(s.CPNFT.Mint(auth, user, level))
+	}
+	clock, err := cpoptest.NewTestClock(ctx, client, auth, &cpoptest.TestClockOptions{Simulated: sim}, s.Staking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(s.Staking.BatchStake(auth, user, ids(1, 2, 3, 4)))
+	if err := clock.AdvanceDays(ctx, 8); err != nil {
+		t.Fatal(err)
+	}
+	mine(s.Staking.BatchClaimRewards(auth, user, ids(1)))
+	if err := clock.AdvanceDays(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	dashboard := cpop.NewStakingDashboard(s.Addresses.StakingReader, s.Addresses.CPNFT, client)
+	// Token 5 is not staked, token 99 does not exist.
+	req := cpop.StakingPageRequest{User: user, Offset: 1, Limit: 2, TokenIDs: ids(1, 5, 99)}
+	snap, err := dashboard.Load(nil, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.BlockNumber != head.Number.Uint64() || snap.Timestamp != head.Time {
+		t.Fatalf("snapshot at block %d time %d, head %s time %d", snap.BlockNumber, snap.Timestamp, head.Number, head.Time)
+	}
+
+	// Move on, so that reads not pinned to the snapshot would differ.
+	if err := clock.AdvanceDays(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	opts := snap.CallOpts(ctx)
+	reader := s.StakingReader
+	summary, err := reader.GetUserStakingSummary(opts, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staked, err := reader.GetUserStakedNFTs(opts, user, big.NewInt(1), big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	combo, err := reader.GetUserComboSummary(opts, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := reader.GetUserRewardStats(opts, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daily, err := reader.GetUserDailyRewards(opts, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"summary", snap.Summary, summary},
+		{"staked page", snap.Staked, staked.Nfts},
+		{"total staked", snap.TotalStaked, staked.Total},
+		{"combo", snap.Combo, combo},
+		{"reward stats", snap.RewardStats, stats},
+		{"daily rewards", snap.DailyRewards, daily},
+	} {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s = %+v, StakingReader %+v", c.name, c.got, c.want)
+		}
+	}
+	// The reads must see the claim and the staked page; all zero values
+	// would compare equal just as well.
+	if len(snap.Staked) != 2 || snap.TotalStaked.Int64() != 4 || summary.TotalStakedCount.Int64() != 4 ||
+		summary.TotalClaimedRewards.Sign() == 0 || summary.TotalPendingRewards.Sign() == 0 || daily.TotalFinalReward.Sign() == 0 {
+		t.Errorf("snapshot %+v", snap)
+	}
+	if latest, err := reader.GetUserStakingSummary(nil, user); err != nil || reflect.DeepEqual(latest, summary) {
+		t.Errorf("latest summary %+v, %v: not after the snapshot", latest, err)
+	}
+
+	// Token reads may fail on their own, with the revert of a direct call.
+	if len(snap.Tokens) != len(req.TokenIDs) {
+		t.Fatalf("%d tokens, want %d", len(snap.Tokens), len(req.TokenIDs))
+	}
+	sameErr := func(name string, got, want error) {
+		t.Helper()
+		if (got == nil) != (want == nil) || (want != nil && got.Error() != cpop.AsRevertError(want).Error()) {
+			t.Errorf("%s error %v, direct call %v", name, got, want)
+		}
+	}
+	for i, tok := range snap.Tokens {
+		if tok.TokenID.Cmp(req.TokenIDs[i]) != 0 {
+			t.Fatalf("token %d is %s, want %s", i, tok.TokenID, req.TokenIDs[i])
+		}
+		level, err := s.CPNFT.GetTokenLevel(opts, tok.TokenID)
+		sameErr("level of "+tok.TokenID.String(), tok.LevelErr, err)
+		if err == nil && tok.Level != level {
+			t.Errorf("token %s level %d, CPNFT %d", tok.TokenID, tok.Level, level)
+		}
+		pending, err := reader.GetPendingRewards(opts, tok.TokenID)
+		sameErr("pending rewards of "+tok.TokenID.String(), tok.PendingErr, err)
+		if err == nil && (tok.PendingRewards == nil || tok.PendingRewards.Cmp(pending) != 0) {
+			t.Errorf("token %s pending %v, StakingReader %s", tok.TokenID, tok.PendingRewards, pending)
+		}
+	}
+	if t1 := snap.Tokens[0]; t1.LevelErr != nil || t1.PendingErr != nil || t1.PendingRewards.Sign() == 0 {
+		t.Errorf("staked token %+v", t1)
+	}
+	if t5 := snap.Tokens[1]; t5.LevelErr != nil || t5.Level != levelC || t5.PendingErr == nil {
+		t.Errorf("unstaked token %+v", t5)
+	}
+	if t99 := snap.Tokens[2]; t99.LevelErr == nil || t99.PendingErr == nil {
+		t.Errorf("nonexistent token %+v", t99)
+	}
+	if revert, ok := cpop.DecodeRevert(snap.Tokens[2].LevelErr); !ok || revert.Name != "ERC721NonexistentToken" {
+		t.Errorf("nonexistent token level error %v", snap.Tokens[2].LevelErr)
+	}
+
+	// The next page read at the snapshot block agrees with the first.
+	next, err := dashboard.Load(opts, cpop.StakingPageRequest{User: user, Offset: 3, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.BlockNumber != snap.BlockNumber || !reflect.DeepEqual(next.Summary, snap.Summary) || len(next.Staked) != 1 || len(next.Tokens) != 0 {
+		t.Errorf("next page %+v", next)
+	}
+}
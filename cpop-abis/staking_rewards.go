@@ -0,0 +1,402 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	basisPoints   = 10000 // basis points denominator of the staking contracts
+	secondsPerDay = 86400 // 1 days
+)
+
+var (
+	// ErrInvalidStakingLevel is returned for levels outside 1 to 6, which
+	// StakingConfig rejects with "Invalid level".
+	ErrInvalidStakingLevel = errors.New("invalid staking level")
+	// ErrRewardMismatch is returned by StakingRewardEngine.Verify when the
+	// engine differs from Staking.calculatePendingRewards.
+	ErrRewardMismatch = errors.New("pending rewards mismatch")
+)
+
+// StakingLevelConfig holds the StakingConfig reward parameters of one level.
+type StakingLevelConfig struct {
+	DailyReward   *big.Int // wei per day before decay and multipliers
+	DecayInterval uint64   // days per decay cycle, 0 disables decay
+	DecayRate     uint64   // basis points per cycle
+	MaxDecayRate  uint64   // basis points
+}
+
+// StakingRewardConfig holds the StakingConfig values read by the Staking
+// reward math.
+type StakingRewardConfig struct {
+	Levels [7]StakingLevelConfig // indexed by level, 0 (NORMAL) is unused
+
+	QuarterlyMultiplier uint64 // used for days before the first historical adjustment
+
+	ContinuousThresholds [2]uint64 // days
+	ContinuousBonuses    [2]uint64 // basis points of the rewards up to the threshold
+
+	HighStakeThreshold  uint64 // staking ratio in basis points
+	LowStakeThreshold   uint64
+	HighStakeMultiplier uint64
+	LowStakeMultiplier  uint64
+}
+
+// StakingAdjustment is a Staking.historicalAdjustments record.
+type StakingAdjustment struct {
+	Timestamp           uint64
+	QuarterlyMultiplier uint64
+	DynamicMultipliers  [7]uint64 // indexed by level
+}
+
+// StakingRewardBreakdown itemizes a pending reward.
+type StakingRewardBreakdown struct {
+	FullDays   *big.Int // rewards of the completed days since the last claim
+	PartialDay *big.Int // per-second share of the current day
+	ComboBonus uint64   // effective combo bonus in basis points
+	Combo      *big.Int // amount added by the combo bonus
+	Continuous *big.Int // continuous staking bonus, zero once claimed
+	Total      *big.Int // calculatePendingRewards
+}
+
+// StakingRewardEngine replicates the reward math of Staking, so pending
+// rewards can be computed offline and under modified configurations. The
+// zero-value fields mirror a contract without historical adjustments.
+type StakingRewardEngine struct {
+	Staking common.Address // contract the state was loaded from, checked by Verify
+
+	Config         StakingRewardConfig
+	Adjustments    []StakingAdjustment // in recording order
+	StakedPerLevel [7]uint64           // Staking.totalStakedPerLevel
+	LevelSupply    [7]uint64           // CPNFT.getLevelSupply
+}
+
+// LoadStakingRewardEngine reads the reward configuration and history of the
+// Staking contract behind the StakingReader at reader. Pin opts to a block to
+// read a consistent state.
+func LoadStakingRewardEngine(opts *bind.CallOpts, backend bind.ContractCaller, reader common.Address) (*StakingRewardEngine, error) {
+	readerCaller, err := NewStakingReaderCaller(reader, backend)
+	if err != nil {
+		return nil, err
+	}
+	e := new(StakingRewardEngine)
+	if e.Staking, err = readerCaller.StakingContract(opts); err != nil {
+		return nil, fmt.Errorf("staking contract: %w", err)
+	}
+	staking, err := NewStakingCaller(e.Staking, backend)
+	if err != nil {
+		return nil, err
+	}
+	configAddr, err := staking.ConfigContract(opts)
+	if err != nil {
+		return nil, fmt.Errorf("config contract: %w", err)
+	}
+	config, err := NewStakingConfigCaller(configAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := config.GetAllLevelConfigs(opts)
+	if err != nil {
+		return nil, fmt.Errorf("level configs: %w", err)
+	}
+	for i := range levels.DailyRewards {
+		e.Config.Levels[i+1] = StakingLevelConfig{
+			DailyReward:   levels.DailyRewards[i],
+			DecayInterval: levels.DecayIntervals[i].Uint64(),
+			DecayRate:     levels.DecayRates[i].Uint64(),
+			MaxDecayRate:  levels.MaxDecayRates[i].Uint64(),
+		}
+	}
+	e.Config.Levels[0].DailyReward = new(big.Int)
+	quarterly, err := config.GetQuarterlyMultiplier(opts)
+	if err != nil {
+		return nil, fmt.Errorf("quarterly multiplier: %w", err)
+	}
+	e.Config.QuarterlyMultiplier = quarterly.Uint64()
+	thresholds, err := config.GetContinuousThresholds(opts)
+	if err != nil {
+		return nil, fmt.Errorf("continuous thresholds: %w", err)
+	}
+	bonuses, err := config.GetContinuousBonuses(opts)
+	if err != nil {
+		return nil, fmt.Errorf("continuous bonuses: %w", err)
+	}
+	for i := range thresholds {
+		e.Config.ContinuousThresholds[i] = thresholds[i].Uint64()
+		e.Config.ContinuousBonuses[i] = bonuses[i].Uint64()
+	}
+	dynamic, err := config.GetDynamicConfig(opts)
+	if err != nil {
+		return nil, fmt.Errorf("dynamic config: %w", err)
+	}
+	e.Config.HighStakeThreshold = dynamic.HighStakeThreshold.Uint64()
+	e.Config.LowStakeThreshold = dynamic.LowStakeThreshold.Uint64()
+	e.Config.HighStakeMultiplier = dynamic.HighStakeMultiplier.Uint64()
+	e.Config.LowStakeMultiplier = dynamic.LowStakeMultiplier.Uint64()
+
+	count, err := staking.GetHistoricalAdjustmentCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("historical adjustment count: %w", err)
+	}
+	for i := uint64(0); i < count.Uint64(); i++ {
+		index := new(big.Int).SetUint64(i)
+		record, err := staking.GetHistoricalAdjustment(opts, index)
+		if err != nil {
+			return nil, fmt.Errorf("historical adjustment %d: %w", i, err)
+		}
+		adj := StakingAdjustment{Timestamp: record.Timestamp.Uint64(), QuarterlyMultiplier: record.QuarterlyMultiplier.Uint64()}
+		for level := uint8(1); level <= 6; level++ {
+			m, err := staking.GetHistoricalDynamicMultiplier(opts, index, level)
+			if err != nil {
+				return nil, fmt.Errorf("historical adjustment %d level %d: %w", i, level, err)
+			}
+			adj.DynamicMultipliers[level] = m.Uint64()
+		}
+		e.Adjustments = append(e.Adjustments, adj)
+	}
+
+	stats, err := readerCaller.GetPlatformStats(opts)
+	if err != nil {
+		return nil, fmt.Errorf("platform stats: %w", err)
+	}
+	for i := range stats.Staked {
+		e.StakedPerLevel[i] = stats.Staked[i].Uint64()
+		e.LevelSupply[i] = stats.Supply[i].Uint64()
+	}
+	return e, nil
+}
+
+// Clone returns a deep copy of e, to be modified for what-if simulations.
+func (e *StakingRewardEngine) Clone() *StakingRewardEngine {
+	c := *e
+	for i, level := range e.Config.Levels {
+		c.Config.Levels[i].DailyReward = new(big.Int).Set(bigOrZero(level.DailyReward))
+	}
+	c.Adjustments = append([]StakingAdjustment(nil), e.Adjustments...)
+	return &c
+}
+
+// levelConfig returns the configuration of level or ErrInvalidStakingLevel.
+func (e *StakingRewardEngine) levelConfig(level uint8) (StakingLevelConfig, error) {
+	if level == 0 || level > 6 {
+		return StakingLevelConfig{}, fmt.Errorf("%w: %d", ErrInvalidStakingLevel, level)
+	}
+	return e.Config.Levels[level], nil
+}
+
+// CurrentDynamicMultiplier mirrors Staking._calculateDynamicMultiplier.
+func (e *StakingRewardEngine) CurrentDynamicMultiplier(level uint8) uint64 {
+	supply := e.LevelSupply[level]
+	if supply == 0 {
+		return basisPoints
+	}
+	ratio := e.StakedPerLevel[level] * basisPoints / supply
+	switch {
+	case ratio > e.Config.HighStakeThreshold:
+		return e.Config.HighStakeMultiplier
+	case ratio < e.Config.LowStakeThreshold:
+		return e.Config.LowStakeMultiplier
+	}
+	return basisPoints
+}
+
+// QuarterlyMultiplier mirrors Staking._getHistoricalQuarterlyMultiplier: the
+// multiplier of the latest adjustment at or before timestamp, or the current
+// StakingConfig multiplier.
+func (e *StakingRewardEngine) QuarterlyMultiplier(timestamp uint64) uint64 {
+	for i := len(e.Adjustments); i > 0; i-- {
+		if adj := e.Adjustments[i-1]; adj.Timestamp <= timestamp {
+			return adj.QuarterlyMultiplier
+		}
+	}
+	return e.Config.QuarterlyMultiplier
+}
+
+// DynamicMultiplier mirrors Staking._getHistoricalDynamicMultiplier.
+func (e *StakingRewardEngine) DynamicMultiplier(level uint8, timestamp uint64) uint64 {
+	for i := len(e.Adjustments); i > 0; i-- {
+		if adj := e.Adjustments[i-1]; adj.Timestamp <= timestamp {
+			return adj.DynamicMultipliers[level]
+		}
+	}
+	return e.CurrentDynamicMultiplier(level)
+}
+
+// DailyReward mirrors Staking._calculateSingleDayReward: the reward of day
+// dayFromStake (0-based) of an NFT of level staked at stakeTime.
+func (e *StakingRewardEngine) DailyReward(level uint8, stakeTime, dayFromStake uint64) (*big.Int, error) {
+	cfg, err := e.levelConfig(level)
+	if err != nil {
+		return nil, err
+	}
+	var cycles uint64
+	if cfg.DecayInterval > 0 && dayFromStake > cfg.DecayInterval {
+		cycles = (dayFromStake - 1) / cfg.DecayInterval
+	}
+	return e.adjustedReward(level, cfg, cycles, stakeTime+dayFromStake*secondsPerDay), nil
+}
+
+// thresholdDailyReward is the daily reward as computed by
+// Staking._calculateContinuousBonus, which counts decay cycles differently
+// from _calculateSingleDayReward: decay starts on day decayInterval rather
+// than decayInterval+1.
+func (e *StakingRewardEngine) thresholdDailyReward(level uint8, cfg StakingLevelConfig, stakeTime, day uint64) *big.Int {
+	var cycles uint64
+	if cfg.DecayInterval > 0 && day >= cfg.DecayInterval {
+		cycles = day / cfg.DecayInterval
+	}
+	return e.adjustedReward(level, cfg, cycles, stakeTime+day*secondsPerDay)
+}
+
+// adjustedReward applies cycles of compound decay, capped at MaxDecayRate,
+// and the quarterly and dynamic multipliers in effect at dayTimestamp.
+func (e *StakingRewardEngine) adjustedReward(level uint8, cfg StakingLevelConfig, cycles, dayTimestamp uint64) *big.Int {
+	reward := new(big.Int).Set(bigOrZero(cfg.DailyReward))
+	for i := uint64(0); i < cycles; i++ {
+		if (i+1)*cfg.DecayRate > cfg.MaxDecayRate {
+			mulBps(reward, basisPoints-(cfg.MaxDecayRate-i*cfg.DecayRate))
+			break
+		}
+		mulBps(reward, basisPoints-cfg.DecayRate)
+	}
+	mulBps(reward, e.QuarterlyMultiplier(dayTimestamp))
+	return mulBps(reward, e.DynamicMultiplier(level, dayTimestamp))
+}
+
+// EffectiveComboBonus mirrors Staking._calculateComboBonus: a combo status
+// only counts once its effectiveFrom time has passed.
+func EffectiveComboBonus(combo StakingComboStatus, now uint64) uint64 {
+	if combo.IsPending && combo.EffectiveFrom != nil && combo.EffectiveFrom.Uint64() <= now {
+		return combo.Bonus.Uint64()
+	}
+	return 0
+}
+
+// ContinuousBonus mirrors Staking._calculateContinuousBonus for a stake that
+// has been staked for stakingDays days.
+func (e *StakingRewardEngine) ContinuousBonus(stake StakingStakeInfo, comboBonus, stakingDays uint64) (*big.Int, error) {
+	var threshold, bonus uint64
+	for i := len(e.Config.ContinuousThresholds); i > 0; i-- {
+		if stakingDays >= e.Config.ContinuousThresholds[i-1] {
+			threshold, bonus = e.Config.ContinuousThresholds[i-1], e.Config.ContinuousBonuses[i-1]
+			break
+		}
+	}
+	if bonus == 0 {
+		return new(big.Int), nil
+	}
+	cfg, err := e.levelConfig(stake.Level)
+	if err != nil {
+		return nil, err
+	}
+	stakeTime := bigOrZero(stake.StakeTime).Uint64()
+	total := new(big.Int)
+	for day := uint64(0); day < threshold; day++ {
+		total.Add(total, e.thresholdDailyReward(stake.Level, cfg, stakeTime, day))
+	}
+	mulBps(total, basisPoints+comboBonus)
+	return mulBps(total, bonus), nil
+}
+
+// PendingRewards mirrors Staking.calculatePendingRewards for stake, with
+// combo the userComboStatus of the stake owner and level and now the
+// Staking.getCurrentTimestamp value.
+func (e *StakingRewardEngine) PendingRewards(stake StakingStakeInfo, combo StakingComboStatus, now uint64) (*StakingRewardBreakdown, error) {
+	stakeTime, lastClaim := bigOrZero(stake.StakeTime).Uint64(), bigOrZero(stake.LastClaimTime).Uint64()
+	if now < lastClaim {
+		return nil, fmt.Errorf("timestamp %d before last claim %d", now, lastClaim)
+	}
+	r := &StakingRewardBreakdown{FullDays: new(big.Int), PartialDay: new(big.Int), Combo: new(big.Int), Continuous: new(big.Int), Total: new(big.Int)}
+	elapsed := now - lastClaim
+	if elapsed == 0 {
+		return r, nil
+	}
+	if _, err := e.levelConfig(stake.Level); err != nil {
+		return nil, err
+	}
+	days, remaining := elapsed/secondsPerDay, elapsed%secondsPerDay
+	firstDay := (lastClaim - stakeTime) / secondsPerDay
+	for day := uint64(0); day < days; day++ {
+		reward, _ := e.DailyReward(stake.Level, stakeTime, firstDay+day)
+		r.FullDays.Add(r.FullDays, reward)
+	}
+	if remaining > 0 {
+		reward, _ := e.DailyReward(stake.Level, stakeTime, firstDay+days)
+		r.PartialDay.Mul(reward, new(big.Int).SetUint64(remaining))
+		r.PartialDay.Quo(r.PartialDay, big.NewInt(secondsPerDay))
+	}
+
+	base := new(big.Int).Add(r.FullDays, r.PartialDay)
+	r.ComboBonus = EffectiveComboBonus(combo, now)
+	r.Total = mulBps(new(big.Int).Set(base), basisPoints+r.ComboBonus)
+	r.Combo.Sub(r.Total, base)
+
+	if !stake.ContinuousBonusClaimed {
+		continuous, err := e.ContinuousBonus(stake, r.ComboBonus, (now-stakeTime)/secondsPerDay)
+		if err != nil {
+			return nil, err
+		}
+		r.Continuous = continuous
+		r.Total.Add(r.Total, continuous)
+	}
+	return r, nil
+}
+
+// Project returns the pending rewards of an NFT of level staked at stakeTime
+// and never claimed, days days later, with comboBonus basis points in effect
+// for the whole period.
+func (e *StakingRewardEngine) Project(level uint8, stakeTime, days, comboBonus uint64) (*StakingRewardBreakdown, error) {
+	start := new(big.Int).SetUint64(stakeTime)
+	stake := StakingStakeInfo{Level: level, StakeTime: start, LastClaimTime: start, IsActive: true}
+	combo := StakingComboStatus{Level: level, EffectiveFrom: new(big.Int), Bonus: new(big.Int).SetUint64(comboBonus), IsPending: comboBonus > 0}
+	return e.PendingRewards(stake, combo, stakeTime+days*secondsPerDay)
+}
+
+// Verify compares the engine with Staking.calculatePendingRewards for each
+// token, returning ErrRewardMismatch on the first difference. The engine must
+// have been loaded at the same block as opts.
+func (e *StakingRewardEngine) Verify(opts *bind.CallOpts, backend bind.ContractCaller, tokenIDs ...*big.Int) error {
+	staking, err := NewStakingCaller(e.Staking, backend)
+	if err != nil {
+		return err
+	}
+	now, err := staking.GetCurrentTimestamp(opts)
+	if err != nil {
+		return fmt.Errorf("current timestamp: %w", err)
+	}
+	for _, id := range tokenIDs {
+		out, err := staking.Stakes(opts, id)
+		if err != nil {
+			return fmt.Errorf("stake %s: %w", id, err)
+		}
+		stake := StakingStakeInfo(out)
+		combo, err := staking.GetComboStatus(opts, stake.Owner, stake.Level)
+		if err != nil {
+			return fmt.Errorf("combo status of %s: %w", id, err)
+		}
+		onchain, err := staking.CalculatePendingRewards(opts, id)
+		if err != nil {
+			return fmt.Errorf("pending rewards of %s: %w", id, AsRevertError(err))
+		}
+		local, err := e.PendingRewards(stake, combo, now.Uint64())
+		if err != nil {
+			return fmt.Errorf("token %s: %w", id, err)
+		}
+		if local.Total.Cmp(onchain) != 0 {
+			return fmt.Errorf("%w: token %s: computed %s, Staking returned %s", ErrRewardMismatch, id, local.Total, onchain)
+		}
+	}
+	return nil
+}
+
+// mulBps sets x to x * m / 10000, rounding down as Solidity does.
+func mulBps(x *big.Int, m uint64) *big.Int {
+	x.Mul(x, new(big.Int).SetUint64(m))
+	return x.Quo(x, big.NewInt(basisPoints))
+}
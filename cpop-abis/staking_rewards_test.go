@@ -0,0 +1,136 @@
+package cpop_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+// CPNFT levels minted by the staking tests.
+const (
+	levelC uint8 = 1
+	levelB uint8 = 2
+)
+
+func TestStakingRewardEngineVerify(t *testing.T) {
+	ctx := context.Background()
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	auth := cpoptest.NewTransactor(key)
+	client := sim.Client()
+	d := &deployer{t: t, sim: sim}
+	core, err := cpoptest.DeployCore(sim, auth, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nftAddr, nft, err := cpoptest.DeployCPNFT(sim, auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := d.mined(cpop.DeployStakingConfig(auth, client))
+	impl := d.mined(cpop.DeployStaking(auth, client))
+	stakingAddr, tx, staking, err := cpop.DeployStakingProxy(auth, client, impl, nftAddr, core.Addresses.CPPToken, core.Addresses.AccountManager, config, auth.From)
+	d.mined(stakingAddr, tx, staking, err)
+	impl = d.mined(cpop.DeployStakingReader(auth, client))
+	reader := d.mined(cpop.DeployStakingReaderProxy(auth, client, impl, stakingAddr, config, nftAddr, auth.From))
+	send := func(tx interface{ Hash() common.Hash }, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sim.Commit()
+	}
+	send(nft.SetStakingContract(auth, stakingAddr))
+
+	// Three C NFTs cross the first combo threshold; the B NFT has no combo.
+	// Two unstaked C NFTs keep the staked share of the level below 100%.
+	user := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	var ids []*big.Int
+	for i, level := range []uint8{levelC, levelC, levelC, levelB, levelC, levelC} {
+		send(nft.Mint(auth, user, level))
+		if i < 4 {
+			ids = append(ids, big.NewInt(int64(i+1)))
+		}
+	}
+
+	clock, err := cpoptest.NewTestClock(ctx, client, auth, &cpoptest.TestClockOptions{Simulated: sim}, staking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(staking.BatchStake(auth, user, ids))
+
+	var sawCombo, sawContinuous bool
+	// Steps in days: a partial first day, the 7 day combo wait, the C decay
+	// interval of 20 days and the 30 and 90 day continuous bonus thresholds.
+	for _, step := range []struct {
+		days, minutes uint64
+	}{
+		{0, 180}, {6, 0}, {1, 1}, {12, 0}, {1, 0}, {9, 30}, {1, 0}, {59, 0}, {1, 0}, {25, 0},
+	} {
+		if step.days > 0 {
+			if err := clock.AdvanceDays(ctx, step.days); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if step.minutes > 0 {
+			if err := clock.AdvanceMinutes(ctx, step.minutes); err != nil {
+				t.Fatal(err)
+			}
+		}
+		opts := &bind.CallOpts{Context: ctx}
+		engine, err := cpop.LoadStakingRewardEngine(opts, client, reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := engine.Verify(opts, client, ids...); err != nil {
+			t.Fatalf("day %.2f: %v", float64(clock.Now()-stakeTime(t, staking, ids[0]))/86400, err)
+		}
+
+		// Verify compares totals; check them directly and record which
+		// bonuses were in effect.
+		for _, id := range ids {
+			stake, err := staking.Stakes(opts, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			combo, err := staking.GetComboStatus(opts, user, stake.Level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			local, err := engine.PendingRewards(cpop.StakingStakeInfo(stake), combo, clock.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			onchain, err := staking.CalculatePendingRewards(opts, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if local.Total.Cmp(onchain) != 0 {
+				t.Fatalf("token %s: computed %s, Staking returned %s", id, local.Total, onchain)
+			}
+			sawCombo = sawCombo || local.Combo.Sign() > 0
+			sawContinuous = sawContinuous || local.Continuous.Sign() > 0
+		}
+	}
+	if !sawCombo || !sawContinuous {
+		t.Fatalf("thresholds not crossed: combo %v, continuous %v", sawCombo, sawContinuous)
+	}
+}
+
+func stakeTime(t *testing.T, staking *cpop.Staking, id *big.Int) uint64 {
+	t.Helper()
+	stake, err := staking.Stakes(nil, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stake.StakeTime.Uint64()
+}
@@ -0,0 +1,274 @@
+package cpop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EntryPointVersion selects how the EntryPoint derives the userOpHash.
+type EntryPointVersion int
+
+const (
+	// EntryPointV07 hashes keccak256(abi.encode(keccak256(pack(op)), entryPoint, chainId)).
+	EntryPointV07 EntryPointVersion = iota
+	// EntryPointV08 uses the EIP-712 typed data hash of contracts/core/EntryPoint.sol.
+	EntryPointV08
+)
+
+var (
+	packedUserOpTypeHash = crypto.Keccak256Hash([]byte("PackedUserOperation(address sender,uint256 nonce,bytes initCode,bytes callData,bytes32 accountGasLimits,uint256 preVerificationGas,bytes32 gasFees,bytes paymasterAndData)"))
+	eip712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	entryPointDomainName = crypto.Keccak256Hash([]byte("ERC4337"))
+	entryPointDomainVer  = crypto.Keccak256Hash([]byte("1"))
+
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// ErrUint128Range is returned when a value packed into half a bytes32 is
+// negative or does not fit into 128 bits.
+var ErrUint128Range = errors.New("value out of uint128 range")
+
+// PackUints packs two uint128 values into a bytes32, high in the upper half.
+// Nil values pack as zero.
+func PackUints(high, low *big.Int) ([32]byte, error) {
+	var packed [32]byte
+	for _, v := range []*big.Int{high, low} {
+		if v != nil && (v.Sign() < 0 || v.Cmp(maxUint128) > 0) {
+			return packed, fmt.Errorf("%w: %s", ErrUint128Range, v)
+		}
+	}
+	if high != nil {
+		high.FillBytes(packed[:16])
+	}
+	if low != nil {
+		low.FillBytes(packed[16:])
+	}
+	return packed, nil
+}
+
+// UnpackUints splits a bytes32 into its high and low uint128 halves.
+func UnpackUints(packed [32]byte) (high, low *big.Int) {
+	return new(big.Int).SetBytes(packed[:16]), new(big.Int).SetBytes(packed[16:])
+}
+
+// PackAccountGasLimits packs the verification and call gas limits into AccountGasLimits.
+func PackAccountGasLimits(verificationGasLimit, callGasLimit *big.Int) ([32]byte, error) {
+	packed, err := PackUints(verificationGasLimit, callGasLimit)
+	if err != nil {
+		return packed, fmt.Errorf("account gas limits: %w", err)
+	}
+	return packed, nil
+}
+
+// PackGasFees packs the priority fee and max fee into GasFees.
+func PackGasFees(maxPriorityFeePerGas, maxFeePerGas *big.Int) ([32]byte, error) {
+	packed, err := PackUints(maxPriorityFeePerGas, maxFeePerGas)
+	if err != nil {
+		return packed, fmt.Errorf("gas fees: %w", err)
+	}
+	return packed, nil
+}
+
+// VerificationGasLimit returns the high half of AccountGasLimits.
+func (op *PackedUserOperation) VerificationGasLimit() *big.Int {
+	high, _ := UnpackUints(op.AccountGasLimits)
+	return high
+}
+
+// CallGasLimit returns the low half of AccountGasLimits.
+func (op *PackedUserOperation) CallGasLimit() *big.Int {
+	_, low := UnpackUints(op.AccountGasLimits)
+	return low
+}
+
+// MaxPriorityFeePerGas returns the high half of GasFees.
+func (op *PackedUserOperation) MaxPriorityFeePerGas() *big.Int {
+	high, _ := UnpackUints(op.GasFees)
+	return high
+}
+
+// MaxFeePerGas returns the low half of GasFees.
+func (op *PackedUserOperation) MaxFeePerGas() *big.Int {
+	_, low := UnpackUints(op.GasFees)
+	return low
+}
+
+// structHash returns keccak256 of the ABI encoded, field-hashed user operation,
+// prefixed with typeHash when it is set.
+func (op *PackedUserOperation) structHash(typeHash *common.Hash) common.Hash {
+	var enc []byte
+	if typeHash != nil {
+		enc = append(enc, typeHash.Bytes()...)
+	}
+	enc = append(enc, common.LeftPadBytes(op.Sender.Bytes(), 32)...)
+	enc = append(enc, word(op.Nonce)...)
+	enc = append(enc, crypto.Keccak256(op.InitCode)...)
+	enc = append(enc, crypto.Keccak256(op.CallData)...)
+	enc = append(enc, op.AccountGasLimits[:]...)
+	enc = append(enc, word(op.PreVerificationGas)...)
+	enc = append(enc, op.GasFees[:]...)
+	enc = append(enc, crypto.Keccak256(op.PaymasterAndData)...)
+	return crypto.Keccak256Hash(enc)
+}
+
+// UserOpHash computes the hash the EntryPoint at entryPoint on chainID
+// passes to the account for signature validation.
+func UserOpHash(op PackedUserOperation, entryPoint common.Address, chainID *big.Int, version EntryPointVersion) common.Hash {
+	if version == EntryPointV08 {
+		domain := crypto.Keccak256Hash(
+			eip712DomainTypeHash.Bytes(),
+			entryPointDomainName.Bytes(),
+			entryPointDomainVer.Bytes(),
+			word(chainID),
+			common.LeftPadBytes(entryPoint.Bytes(), 32),
+		)
+		return crypto.Keccak256Hash([]byte{0x19, 0x01}, domain.Bytes(), op.structHash(&packedUserOpTypeHash).Bytes())
+	}
+	return crypto.Keccak256Hash(
+		op.structHash(nil).Bytes(),
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		word(chainID),
+	)
+}
+
+// word left-pads a non-negative integer to a 32 byte ABI word.
+func word(v *big.Int) []byte {
+	if v == nil {
+		return make([]byte, 32)
+	}
+	return common.LeftPadBytes(v.Bytes(), 32)
+}
+
+// EncodeExecute returns the AAccount.execute calldata for a single call.
+func EncodeExecute(target common.Address, value *big.Int, data []byte) ([]byte, error) {
+	parsed, err := AAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+	return parsed.Pack("execute", target, value, data)
+}
+
+// EncodeExecuteBatch returns the AAccount.executeBatch calldata for calls.
+// Nil values are encoded as zero; calls is not modified.
+func EncodeExecuteBatch(calls []BaseAccountCall) ([]byte, error) {
+	parsed, err := AAccountMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	calls = append([]BaseAccountCall(nil), calls...)
+	for i := range calls {
+		if calls[i].Value == nil {
+			calls[i].Value = new(big.Int)
+		}
+	}
+	return parsed.Pack("executeBatch", calls)
+}
+
+// UserOpGas holds the unpacked gas fields of a user operation.
+type UserOpGas struct {
+	VerificationGasLimit *big.Int
+	CallGasLimit         *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+// UserOpBuilder assembles unsigned PackedUserOperations for AAccount wallets
+// created through an AccountManager.
+type UserOpBuilder struct {
+	backend    bind.ContractCaller
+	manager    *AccountManagerCaller
+	entryPoint common.Address
+	chainID    *big.Int
+	version    EntryPointVersion
+}
+
+// NewUserOpBuilder creates a builder for accounts of the AccountManager at
+// accountManager, targeting the given EntryPoint deployment.
+func NewUserOpBuilder(backend bind.ContractCaller, accountManager common.Address, entryPoint common.Address, chainID *big.Int, version EntryPointVersion) (*UserOpBuilder, error) {
+	if chainID == nil {
+		return nil, errors.New("chain ID required")
+	}
+	manager, err := NewAccountManagerCaller(accountManager, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &UserOpBuilder{
+		backend:    backend,
+		manager:    manager,
+		entryPoint: entryPoint,
+		chainID:    chainID,
+		version:    version,
+	}, nil
+}
+
+// Build returns an unsigned user operation executing callData from the
+// account of owner and masterSigner (zero for the default master signer of
+// the AccountManager). Sender and nonce are read from the chain; initCode is
+// filled in when the account is not deployed yet.
+func (b *UserOpBuilder) Build(opts *bind.CallOpts, owner, masterSigner common.Address, callData []byte, gas UserOpGas) (PackedUserOperation, error) {
+	accountGasLimits, err := PackAccountGasLimits(gas.VerificationGasLimit, gas.CallGasLimit)
+	if err != nil {
+		return PackedUserOperation{}, err
+	}
+	gasFees, err := PackGasFees(gas.MaxPriorityFeePerGas, gas.MaxFeePerGas)
+	if err != nil {
+		return PackedUserOperation{}, err
+	}
+	// getInitCode rejects the zero master signer, so the default one is
+	// resolved once and used for all lookups.
+	if masterSigner == (common.Address{}) {
+		if masterSigner, err = b.manager.DefaultMasterSigner(opts); err != nil {
+			return PackedUserOperation{}, fmt.Errorf("default master signer: %w", err)
+		}
+	}
+	sender, err := b.manager.GetAccountAddress(opts, owner, masterSigner)
+	if err != nil {
+		return PackedUserOperation{}, fmt.Errorf("account address: %w", err)
+	}
+	deployed, err := b.manager.IsAccountDeployed(opts, owner, masterSigner)
+	if err != nil {
+		return PackedUserOperation{}, fmt.Errorf("account deployed: %w", err)
+	}
+	op := PackedUserOperation{
+		Sender:             sender,
+		Nonce:              new(big.Int),
+		CallData:           callData,
+		AccountGasLimits:   accountGasLimits,
+		PreVerificationGas: gas.PreVerificationGas,
+		GasFees:            gasFees,
+		PaymasterAndData:   []byte{},
+		Signature:          []byte{},
+	}
+	if op.PreVerificationGas == nil {
+		op.PreVerificationGas = new(big.Int)
+	}
+	if deployed {
+		account, err := NewAAccountCaller(sender, b.backend)
+		if err != nil {
+			return PackedUserOperation{}, err
+		}
+		if op.Nonce, err = account.GetNonce(opts); err != nil {
+			return PackedUserOperation{}, fmt.Errorf("account nonce: %w", err)
+		}
+		op.InitCode = []byte{}
+	} else {
+		if op.InitCode, err = b.manager.GetInitCode(opts, owner, masterSigner); err != nil {
+			return PackedUserOperation{}, fmt.Errorf("init code: %w", err)
+		}
+	}
+	return op, nil
+}
+
+// Hash returns the userOpHash of op for the builder's EntryPoint and chain.
+func (b *UserOpBuilder) Hash(op PackedUserOperation) common.Hash {
+	return UserOpHash(op, b.entryPoint, b.chainID, b.version)
+}
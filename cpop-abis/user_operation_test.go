@@ -0,0 +1,182 @@
+package cpop_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cpop "github.com/chapool/account-abstraction/cpop-abis"
+	"github.com/chapool/account-abstraction/cpop-abis/cpoptest"
+)
+
+func TestPackUints(t *testing.T) {
+	maxUint128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+	tests := []struct {
+		name      string
+		high, low *big.Int
+		want      string
+		err       bool
+	}{
+		{"nil", nil, nil, "0x0000000000000000000000000000000000000000000000000000000000000000", false},
+		{"zero", new(big.Int), new(big.Int), "0x0000000000000000000000000000000000000000000000000000000000000000", false},
+		{"halves", big.NewInt(1), big.NewInt(2), "0x0000000000000000000000000000000100000000000000000000000000000002", false},
+		{"max high", maxUint128, nil, "0xffffffffffffffffffffffffffffffff00000000000000000000000000000000", false},
+		{"max low", nil, maxUint128, "0x00000000000000000000000000000000ffffffffffffffffffffffffffffffff", false},
+		{"max both", maxUint128, maxUint128, "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"high overflow", new(big.Int).Add(maxUint128, big.NewInt(1)), nil, "", true},
+		{"low overflow", nil, new(big.Int).Add(maxUint128, big.NewInt(1)), "", true},
+		{"negative", big.NewInt(-1), nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packed, err := cpop.PackUints(tt.high, tt.low)
+			if tt.err {
+				if !errors.Is(err, cpop.ErrUint128Range) {
+					t.Fatalf("err = %v, want ErrUint128Range", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := common.Hash(packed).Hex(); got != tt.want {
+				t.Fatalf("packed = %s, want %s", got, tt.want)
+			}
+			high, low := cpop.UnpackUints(packed)
+			if want := bigOrZero(tt.high); high.Cmp(want) != 0 {
+				t.Errorf("high = %s, want %s", high, want)
+			}
+			if want := bigOrZero(tt.low); low.Cmp(want) != 0 {
+				t.Errorf("low = %s, want %s", low, want)
+			}
+		})
+	}
+
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 128)
+	if _, err := cpop.PackAccountGasLimits(big.NewInt(1), tooBig); !errors.Is(err, cpop.ErrUint128Range) {
+		t.Errorf("PackAccountGasLimits err = %v, want ErrUint128Range", err)
+	}
+	if _, err := cpop.PackGasFees(tooBig, big.NewInt(1)); !errors.Is(err, cpop.ErrUint128Range) {
+		t.Errorf("PackGasFees err = %v, want ErrUint128Range", err)
+	}
+}
+
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// testUserOp returns the user operation of the userOpHash vectors.
+func testUserOp(t *testing.T) cpop.PackedUserOperation {
+	t.Helper()
+	limits, err := cpop.PackAccountGasLimits(big.NewInt(100000), big.NewInt(200000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fees, err := cpop.PackGasFees(big.NewInt(1e9), big.NewInt(2e9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cpop.PackedUserOperation{
+		Sender:             common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Nonce:              big.NewInt(7),
+		InitCode:           []byte{0xde, 0xad},
+		CallData:           []byte{0xbe, 0xef},
+		AccountGasLimits:   limits,
+		PreVerificationGas: big.NewInt(50000),
+		GasFees:            fees,
+		PaymasterAndData:   []byte{},
+		Signature:          []byte{},
+	}
+}
+
+func TestUserOpHashV07(t *testing.T) {
+	// Computed by EntryPoint.getUserOpHash of account-abstraction v0.7.0 for
+	// the EntryPoint at its canonical address on BSC.
+	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	want := common.HexToHash("0x6f08c3f4687b58ee8c2ab5829165ccbfbeaa31f58b0c52c9f5454e3ae8f2a935")
+	if got := cpop.UserOpHash(testUserOp(t), entryPoint, big.NewInt(56), cpop.EntryPointV07); got != want {
+		t.Fatalf("userOpHash = %s, want %s", got.Hex(), want.Hex())
+	}
+}
+
+func TestUserOpHashV08(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	addr, tx, entryPoint, err := cpop.DeployEntryPoint(cpoptest.NewTransactor(key), sim.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cpoptest.Mine(sim, tx); err != nil {
+		t.Fatal(err)
+	}
+	op := testUserOp(t)
+	want, err := entryPoint.GetUserOpHash(nil, op)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cpop.UserOpHash(op, addr, cpoptest.ChainID, cpop.EntryPointV08); got != want {
+		t.Fatalf("userOpHash = %s, want %s", got.Hex(), common.Hash(want).Hex())
+	}
+}
+
+func TestEncodeExecuteBatchKeepsCalls(t *testing.T) {
+	calls := []cpop.BaseAccountCall{{Target: common.HexToAddress("0x01"), Data: []byte{1}}}
+	if _, err := cpop.EncodeExecuteBatch(calls); err != nil {
+		t.Fatal(err)
+	}
+	if calls[0].Value != nil {
+		t.Fatalf("EncodeExecuteBatch set the caller's value to %s", calls[0].Value)
+	}
+}
+
+func TestUserOpBuilderDefaultMasterSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sim := cpoptest.NewBackend(key)
+	defer sim.Close()
+	master := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	core, err := cpoptest.DeployCore(sim, cpoptest.NewTransactor(key), &cpoptest.CoreOptions{MasterSigner: master})
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, err := cpop.NewUserOpBuilder(sim.Client(), core.Addresses.AccountManager, core.Addresses.EntryPoint, cpoptest.ChainID, cpop.EntryPointV08)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	op, err := builder.Build(nil, owner, common.Address{}, []byte{}, cpop.UserOpGas{
+		VerificationGasLimit: big.NewInt(500000),
+		CallGasLimit:         big.NewInt(100000),
+		MaxFeePerGas:         big.NewInt(1e9),
+		MaxPriorityFeePerGas: big.NewInt(1e9),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender, err := core.AccountManager.GetAccountAddress(nil, owner, master)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Sender != sender {
+		t.Errorf("sender = %s, want %s", op.Sender.Hex(), sender.Hex())
+	}
+	initCode, err := core.AccountManager.GetInitCode(nil, owner, master)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(op.InitCode, initCode) {
+		t.Errorf("initCode = %x, want %x", op.InitCode, initCode)
+	}
+
+	_, err = builder.Build(nil, owner, master, nil, cpop.UserOpGas{CallGasLimit: new(big.Int).Lsh(big.NewInt(1), 128)})
+	if !errors.Is(err, cpop.ErrUint128Range) {
+		t.Errorf("Build err = %v, want ErrUint128Range", err)
+	}
+}